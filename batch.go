@@ -0,0 +1,130 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// BatchOptions is the batch runner options
+type BatchOptions struct {
+	// Size is the maximum rows per batch, default is 1000
+	Size int64
+
+	// Pause is the duration to wait between batches
+	Pause time.Duration
+
+	// Progress is called after each batch
+	Progress func(p BatchProgress)
+
+	// NextKey returns the last key of the batch after given key,
+	// or ok = false when no more rows.
+	// When set, batch runs in keyset mode and stops when NextKey returns ok = false,
+	// otherwise batch stops when rows affected less than batch size.
+	NextKey func(ctx context.Context, after interface{}, size int64) (key interface{}, ok bool, err error)
+}
+
+// Batch is the current batch
+type Batch struct {
+	// Number is the batch number, start from 1
+	Number int
+
+	// Size is the maximum rows for this batch
+	Size int64
+
+	// After is the last key of previous batch (exclusive), nil for the first batch.
+	// Only available in keyset mode.
+	After interface{}
+
+	// Until is the last key of this batch (inclusive).
+	// Only available in keyset mode.
+	Until interface{}
+}
+
+// BatchProgress is the batch progress
+type BatchProgress struct {
+	Batch
+	RowsAffected int64
+	Total        int64
+}
+
+const (
+	defaultBatchSize = 1000
+)
+
+// RunBatch runs fn repeatedly until all rows are processed,
+// fn must limit the statement to batch size (ex. delete ... limit ?),
+// or to the key range in keyset mode (ex. delete ... where id > ? and id <= ?).
+//
+// In non-keyset mode, fn must not match already processed rows,
+// or RunBatch will never stop.
+func RunBatch(ctx context.Context, opts *BatchOptions, fn func(ctx context.Context, b *Batch) (sql.Result, error)) (int64, error) {
+	option := BatchOptions{
+		Size: defaultBatchSize,
+	}
+	if opts != nil {
+		option = *opts
+		if option.Size <= 0 {
+			option.Size = defaultBatchSize
+		}
+	}
+
+	var (
+		total int64
+		after interface{}
+	)
+	for i := 1; ; i++ {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		b := Batch{
+			Number: i,
+			Size:   option.Size,
+		}
+		if option.NextKey != nil {
+			key, ok, err := option.NextKey(ctx, after, option.Size)
+			if err != nil {
+				return total, err
+			}
+			if !ok {
+				return total, nil
+			}
+			b.After = after
+			b.Until = key
+			after = key
+		}
+
+		res, err := fn(ctx, &b)
+		if err != nil {
+			return total, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+
+		if option.Progress != nil {
+			option.Progress(BatchProgress{
+				Batch:        b,
+				RowsAffected: n,
+				Total:        total,
+			})
+		}
+
+		if option.NextKey == nil && n < option.Size {
+			return total, nil
+		}
+
+		if option.Pause > 0 {
+			t := time.NewTimer(option.Pause)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return total, ctx.Err()
+			case <-t.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql"
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestRunBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Delete until less than size", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		query := regexp.QuoteMeta("delete from events where (created_at < ?) order by id limit 2")
+		mock.ExpectExec(query).WithArgs("2020-01-01").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(query).WithArgs("2020-01-01").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(query).WithArgs("2020-01-01").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		var progress []mysql.BatchProgress
+		total, err := mysql.RunBatch(context.Background(), &mysql.BatchOptions{
+			Size: 2,
+			Progress: func(p mysql.BatchProgress) {
+				progress = append(progress, p)
+			},
+		}, func(ctx context.Context, b *mysql.Batch) (sql.Result, error) {
+			return mystmt.Delete(func(st mystmt.DeleteStatement) {
+				st.From("events")
+				st.Where(func(st mystmt.Cond) {
+					st.Lt("created_at", "2020-01-01")
+				})
+				st.OrderBy("id")
+				st.Limit(b.Size)
+			}).ExecContext(ctx, db.ExecContext)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		if assert.Len(t, progress, 3) {
+			assert.Equal(t, 1, progress[0].Number)
+			assert.Equal(t, int64(2), progress[0].RowsAffected)
+			assert.Equal(t, 3, progress[2].Number)
+			assert.Equal(t, int64(1), progress[2].RowsAffected)
+			assert.Equal(t, int64(5), progress[2].Total)
+		}
+	})
+
+	t.Run("Keyset", func(t *testing.T) {
+		keys := []int64{100, 200, 250}
+		var ranges [][2]interface{}
+		total, err := mysql.RunBatch(context.Background(), &mysql.BatchOptions{
+			Size: 100,
+			NextKey: func(ctx context.Context, after interface{}, size int64) (interface{}, bool, error) {
+				if len(keys) == 0 {
+					return nil, false, nil
+				}
+				k := keys[0]
+				keys = keys[1:]
+				return k, true, nil
+			},
+		}, func(ctx context.Context, b *mysql.Batch) (sql.Result, error) {
+			ranges = append(ranges, [2]interface{}{b.After, b.Until})
+			return sqlmock.NewResult(0, 10), nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(30), total)
+		assert.Equal(t, [][2]interface{}{
+			{nil, int64(100)},
+			{int64(100), int64(200)},
+			{int64(200), int64(250)},
+		}, ranges)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		retErr := fmt.Errorf("error")
+		total, err := mysql.RunBatch(context.Background(), nil, func(ctx context.Context, b *mysql.Batch) (sql.Result, error) {
+			if b.Number == 2 {
+				return nil, retErr
+			}
+			assert.Equal(t, int64(1000), b.Size)
+			return sqlmock.NewResult(0, 1000), nil
+		})
+		assert.Equal(t, retErr, err)
+		assert.Equal(t, int64(1000), total)
+	})
+
+	t.Run("Cancel during pause", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		called := 0
+		total, err := mysql.RunBatch(ctx, &mysql.BatchOptions{
+			Size:  10,
+			Pause: time.Hour,
+		}, func(ctx context.Context, b *mysql.Batch) (sql.Result, error) {
+			called++
+			time.AfterFunc(10*time.Millisecond, cancel)
+			return sqlmock.NewResult(0, 10), nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, int64(10), total)
+		assert.Equal(t, 1, called)
+	})
+}
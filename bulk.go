@@ -0,0 +1,271 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Execer interface
+type Execer interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+}
+
+// RowIterator returns next row, or io.EOF when no more rows
+type RowIterator func() ([]interface{}, error)
+
+// RowsOf creates RowIterator from rows
+func RowsOf(rows [][]interface{}) RowIterator {
+	var i int
+	return func() ([]interface{}, error) {
+		if i >= len(rows) {
+			return nil, io.EOF
+		}
+		i++
+		return rows[i-1], nil
+	}
+}
+
+// BulkInsertMode is the bulk insert mode
+type BulkInsertMode int
+
+// Bulk insert modes
+const (
+	// BulkInsertDefault inserts rows, duplicate key returns error
+	BulkInsertDefault BulkInsertMode = iota
+
+	// BulkInsertIgnore inserts rows with insert ignore
+	BulkInsertIgnore
+
+	// BulkInsertUpsert inserts rows with on duplicate key update
+	BulkInsertUpsert
+)
+
+// BulkInsertOptions is the bulk insert options
+type BulkInsertOptions struct {
+	// Mode is the insert mode
+	Mode BulkInsertMode
+
+	// UpdateColumns is the columns to update on duplicate key,
+	// default is all columns. Only use with BulkInsertUpsert mode
+	UpdateColumns []string
+
+	// MaxPlaceholders is the maximum placeholders per statement, default is 65535
+	MaxPlaceholders int
+
+	// MaxBytes is the estimated maximum packet size per statement,
+	// should less than server's max_allowed_packet, default is 4 MiB
+	MaxBytes int
+
+	// NoTx runs each batch outside transaction.
+	// If db is not BeginTxer (ex. *sql.Tx), batches always run on db
+	NoTx bool
+
+	// TxOptions is the transaction options, transaction will not retry.
+	// Default isolation level is the server's isolation level
+	TxOptions sql.TxOptions
+
+	// Result is called after each batch.
+	// In tx mode, batches are not committed yet and will be rolled back on error
+	Result func(r BulkInsertResult)
+}
+
+// BulkInsertResult is the result of a batch
+type BulkInsertResult struct {
+	// Batch is the batch number, start from 1
+	Batch        int
+	Rows         int
+	RowsAffected int64
+	LastInsertID int64
+}
+
+const (
+	defaultMaxPlaceholders = 65535
+	defaultMaxBytes        = 4 << 20
+)
+
+// BulkInsert inserts rows into table, splits into multiple statements
+// to not exceed placeholders and packet size limits.
+// It returns total rows affected, or 0 when tx is rolled back.
+func BulkInsert(ctx context.Context, db Execer, table string, columns []string, rows RowIterator, opts *BulkInsertOptions) (int64, error) {
+	if len(columns) == 0 {
+		return 0, fmt.Errorf("mysql: bulk insert require columns")
+	}
+
+	var option BulkInsertOptions
+	if opts != nil {
+		option = *opts
+	}
+	if option.MaxPlaceholders <= 0 {
+		option.MaxPlaceholders = defaultMaxPlaceholders
+	}
+	if option.MaxBytes <= 0 {
+		option.MaxBytes = defaultMaxBytes
+	}
+
+	var total int64
+	run := func(db Execer) error {
+		total = 0
+		return bulkInsert(ctx, db, table, columns, rows, &option, &total)
+	}
+
+	if txer, ok := db.(BeginTxer); ok && !option.NoTx {
+		// not use RunInTxContext, it overrides default isolation level to serializable
+		tx, err := txer.BeginTx(ctx, &option.TxOptions)
+		if err != nil {
+			return 0, err
+		}
+		defer tx.Rollback()
+
+		// nothing is persisted when tx is rolled back
+		err = run(tx)
+		if err != nil {
+			return 0, err
+		}
+		err = tx.Commit()
+		if err != nil {
+			return 0, err
+		}
+		return total, nil
+	}
+	err := run(db)
+	return total, err
+}
+
+func bulkInsert(ctx context.Context, db Execer, table string, columns []string, rows RowIterator, option *BulkInsertOptions, total *int64) error {
+	prefix, suffix := bulkInsertSQL(table, columns, option)
+	rowSQL := "(" + strings.Repeat("?, ", len(columns)-1) + "?)"
+	maxRows := option.MaxPlaceholders / len(columns)
+	if maxRows == 0 {
+		return fmt.Errorf("mysql: bulk insert columns exceed max placeholders")
+	}
+
+	var (
+		batch int
+		n     int
+		size  int
+		args  []interface{}
+	)
+	exec := func() error {
+		if n == 0 {
+			return nil
+		}
+		batch++
+
+		var q strings.Builder
+		q.Grow(len(prefix) + n*(len(rowSQL)+2) + len(suffix))
+		q.WriteString(prefix)
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				q.WriteString(", ")
+			}
+			q.WriteString(rowSQL)
+		}
+		q.WriteString(suffix)
+
+		res, err := db.ExecContext(ctx, q.String(), args...)
+		if err != nil {
+			return err
+		}
+		r := BulkInsertResult{
+			Batch: batch,
+			Rows:  n,
+		}
+		r.RowsAffected, err = res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		r.LastInsertID, _ = res.LastInsertId()
+		*total += r.RowsAffected
+		if option.Result != nil {
+			option.Result(r)
+		}
+
+		n = 0
+		size = 0
+		args = args[:0]
+		return nil
+	}
+
+	for {
+		row, err := rows()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(row) != len(columns) {
+			return fmt.Errorf("mysql: bulk insert row has %d values, expected %d", len(row), len(columns))
+		}
+
+		rowSize := len(rowSQL) + 2
+		for _, v := range row {
+			rowSize += estimateArgSize(v)
+		}
+		if n > 0 && (n >= maxRows || len(prefix)+len(suffix)+size+rowSize > option.MaxBytes) {
+			err = exec()
+			if err != nil {
+				return err
+			}
+		}
+		if args == nil {
+			args = make([]interface{}, 0, maxRows*len(columns))
+		}
+		args = append(args, row...)
+		size += rowSize
+		n++
+	}
+	return exec()
+}
+
+func bulkInsertSQL(table string, columns []string, option *BulkInsertOptions) (prefix string, suffix string) {
+	prefix = "insert into "
+	if option.Mode == BulkInsertIgnore {
+		prefix = "insert ignore into "
+	}
+	prefix += table + " (" + strings.Join(columns, ", ") + ") values "
+
+	if option.Mode == BulkInsertUpsert {
+		cols := option.UpdateColumns
+		if len(cols) == 0 {
+			cols = columns
+		}
+		sets := make([]string, len(cols))
+		for i, c := range cols {
+			sets[i] = c + " = values(" + c + ")"
+		}
+		suffix = " on duplicate key update " + strings.Join(sets, ", ")
+	}
+	return
+}
+
+// estimateArgSize estimates value size in binary protocol
+func estimateArgSize(v interface{}) int {
+	// 2 bytes for parameter type
+	switch v := v.(type) {
+	case nil:
+		return 2
+	case string:
+		return 2 + 9 + len(v)
+	case []byte:
+		return 2 + 9 + len(v)
+	case time.Time:
+		return 2 + 12
+	case driver.Valuer:
+		x, err := v.Value()
+		if err != nil {
+			return 2
+		}
+		if _, ok := x.(driver.Valuer); ok {
+			return 2 + 8
+		}
+		return estimateArgSize(x)
+	default:
+		return 2 + 8
+	}
+}
@@ -0,0 +1,204 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql"
+)
+
+func TestBulkInsert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Split by placeholders in tx", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id, name) values (?, ?), (?, ?)")).
+			WithArgs(1, "a", 2, "b").
+			WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id, name) values (?, ?)")).
+			WithArgs(3, "c").
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectCommit()
+
+		var results []mysql.BulkInsertResult
+		total, err := mysql.BulkInsert(context.Background(), db, "users", []string{"id", "name"}, mysql.RowsOf([][]interface{}{
+			{1, "a"},
+			{2, "b"},
+			{3, "c"},
+		}), &mysql.BulkInsertOptions{
+			MaxPlaceholders: 5,
+			Result: func(r mysql.BulkInsertResult) {
+				results = append(results, r)
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, []mysql.BulkInsertResult{
+			{Batch: 1, Rows: 2, RowsAffected: 2, LastInsertID: 2},
+			{Batch: 2, Rows: 1, RowsAffected: 1, LastInsertID: 3},
+		}, results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Split by bytes without tx", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		data := strings.Repeat("x", 100)
+		query := regexp.QuoteMeta("insert ignore into files (data) values (?)")
+		mock.ExpectExec(query).WithArgs(data).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(query).WithArgs(data).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		total, err := mysql.BulkInsert(context.Background(), db, "files", []string{"data"}, mysql.RowsOf([][]interface{}{
+			{data},
+			{data},
+		}), &mysql.BulkInsertOptions{
+			Mode:     mysql.BulkInsertIgnore,
+			MaxBytes: 150,
+			NoTx:     true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id, name, email) values (?, ?, ?) on duplicate key update name = values(name), email = values(email)")).
+			WithArgs(1, "a", nil).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		total, err := mysql.BulkInsert(context.Background(), db, "users", []string{"id", "name", "email"}, mysql.RowsOf([][]interface{}{
+			{1, "a", nil},
+		}), &mysql.BulkInsertOptions{
+			Mode:          mysql.BulkInsertUpsert,
+			UpdateColumns: []string{"name", "email"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Invalid row rollback", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		_, err = mysql.BulkInsert(context.Background(), db, "users", []string{"id", "name"}, mysql.RowsOf([][]interface{}{
+			{1},
+		}), nil)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Batch error rollback", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id) values (?), (?)")).
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id) values (?)")).
+			WithArgs(3).
+			WillReturnError(fmt.Errorf("duplicate"))
+		mock.ExpectRollback()
+
+		var results []mysql.BulkInsertResult
+		total, err := mysql.BulkInsert(context.Background(), db, "users", []string{"id"}, mysql.RowsOf([][]interface{}{
+			{1},
+			{2},
+			{3},
+		}), &mysql.BulkInsertOptions{
+			MaxPlaceholders: 2,
+			Result: func(r mysql.BulkInsertResult) {
+				results = append(results, r)
+			},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Len(t, results, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Commit error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("insert into users (id) values (?)")).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit().WillReturnError(fmt.Errorf("commit"))
+
+		total, err := mysql.BulkInsert(context.Background(), db, "users", []string{"id"}, mysql.RowsOf([][]interface{}{
+			{1},
+		}), nil)
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		total, err := mysql.BulkInsert(context.Background(), db, "users", []string{"id"}, mysql.RowsOf(nil), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Default isolation level", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		txer := &txOptionsRecorder{DB: db}
+		_, err = mysql.BulkInsert(context.Background(), txer, "users", []string{"id"}, mysql.RowsOf(nil), nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, txer.opts) {
+			assert.Equal(t, sql.LevelDefault, txer.opts.Isolation)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+type txOptionsRecorder struct {
+	*sql.DB
+	opts *sql.TxOptions
+}
+
+func (r *txOptionsRecorder) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	r.opts = opts
+	return r.DB.BeginTx(ctx, opts)
+}
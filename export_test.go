@@ -0,0 +1,14 @@
+package mysql
+
+import (
+	"io"
+)
+
+// SetReaderHandler replaces reader handler registration, returns function to restore
+func SetReaderHandler(register func(name string, handler func() io.Reader), deregister func(name string)) (restore func()) {
+	r, d := registerReaderHandler, deregisterReaderHandler
+	registerReaderHandler, deregisterReaderHandler = register, deregister
+	return func() {
+		registerReaderHandler, deregisterReaderHandler = r, d
+	}
+}
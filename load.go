@@ -0,0 +1,311 @@
+package mysql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	dmysql "github.com/go-sql-driver/mysql"
+)
+
+// LoadDataFormat is the load data file format
+type LoadDataFormat int
+
+// Load data formats
+const (
+	// LoadDataTSV is the tab-separated format with backslash escape and \N as NULL,
+	// this is the format written by EncodeLoadData
+	LoadDataTSV LoadDataFormat = iota
+
+	// LoadDataCSV is the comma-separated format with RFC 4180 double quote,
+	// unquoted NULL is read as NULL.
+	// Lines must end with \n, \r\n line ending leaves \r in the last column
+	LoadDataCSV
+)
+
+// LoadDataOptions is the load data options
+type LoadDataOptions struct {
+	// Format is the data format, LoadData always use LoadDataTSV
+	Format LoadDataFormat
+
+	// CharacterSet is the data character set, default is utf8mb4
+	CharacterSet string
+
+	// Replace replaces existing rows on duplicate key,
+	// otherwise duplicate rows are skipped
+	Replace bool
+
+	// IgnoreLines is the number of lines to skip at the start of data (ex. CSV header)
+	IgnoreLines int
+}
+
+// LoadDataResult is the load data result
+type LoadDataResult struct {
+	RowsAffected int64
+	Warnings     []Warning
+}
+
+// Warning is the server warning
+type Warning struct {
+	Level   string
+	Code    int
+	Message string
+}
+
+// LoadDataDB is the database for load data
+type LoadDataDB interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+}
+
+var loadDataID uint64
+
+// reader handler registration, tests replace to read data without server
+var (
+	registerReaderHandler   = dmysql.RegisterReaderHandler
+	deregisterReaderHandler = dmysql.DeregisterReaderHandler
+)
+
+// LoadData streams rows into table using LOAD DATA LOCAL INFILE.
+//
+// LOAD DATA is not atomic outside transaction, when rows or encoder returns error
+// in the middle of stream, rows already sent are kept but LoadData returns error.
+// Use *sql.Tx as db to rollback all rows on error.
+func LoadData(ctx context.Context, db LoadDataDB, table string, columns []string, rows RowIterator, opts *LoadDataOptions) (*LoadDataResult, error) {
+	var option LoadDataOptions
+	if opts != nil {
+		option = *opts
+	}
+	option.Format = LoadDataTSV
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := EncodeLoadData(pw, rows)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	res, err := loadData(ctx, db, table, columns, pr, &option)
+
+	// unblock writer when server did not read all data
+	pr.Close()
+	if encErr := <-done; encErr != nil && !errors.Is(encErr, io.ErrClosedPipe) {
+		return nil, encErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// LoadDataReader streams data from r into table using LOAD DATA LOCAL INFILE.
+func LoadDataReader(ctx context.Context, db LoadDataDB, table string, columns []string, r io.Reader, opts *LoadDataOptions) (*LoadDataResult, error) {
+	var option LoadDataOptions
+	if opts != nil {
+		option = *opts
+	}
+	return loadData(ctx, db, table, columns, r, &option)
+}
+
+func loadData(ctx context.Context, db LoadDataDB, table string, columns []string, r io.Reader, option *LoadDataOptions) (*LoadDataResult, error) {
+	// warnings must query from the same connection
+	if p, ok := db.(interface {
+		Conn(context.Context) (*sql.Conn, error)
+	}); ok {
+		conn, err := p.Conn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db = conn
+	}
+
+	name := "mysql_load_data_" + strconv.FormatUint(atomic.AddUint64(&loadDataID, 1), 10)
+	registerReaderHandler(name, func() io.Reader { return r })
+	defer deregisterReaderHandler(name)
+
+	res, err := db.ExecContext(ctx, loadDataSQL(name, table, columns, option))
+	if err != nil {
+		return nil, err
+	}
+
+	var result LoadDataResult
+	result.RowsAffected, err = res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	err = IterContext(ctx, db, func(scan Scanner) error {
+		var w Warning
+		err := scan(&w.Level, &w.Code, &w.Message)
+		if err != nil {
+			return err
+		}
+		result.Warnings = append(result.Warnings, w)
+		return nil
+	}, "show warnings")
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func loadDataSQL(name, table string, columns []string, option *LoadDataOptions) string {
+	var b strings.Builder
+	b.WriteString("load data local infile 'Reader::" + name + "'")
+	if option.Replace {
+		b.WriteString(" replace")
+	}
+	b.WriteString(" into table " + table)
+
+	charset := option.CharacterSet
+	if charset == "" {
+		charset = "utf8mb4"
+	}
+	b.WriteString(" character set " + charset)
+
+	switch option.Format {
+	case LoadDataCSV:
+		b.WriteString(` fields terminated by ',' optionally enclosed by '"' escaped by '' lines terminated by X'0A'`)
+	default:
+		// hex literals do not depend on NO_BACKSLASH_ESCAPES
+		b.WriteString(` fields terminated by X'09' escaped by X'5C' lines terminated by X'0A'`)
+	}
+
+	if option.IgnoreLines > 0 {
+		b.WriteString(" ignore " + strconv.Itoa(option.IgnoreLines) + " lines")
+	}
+	if len(columns) > 0 {
+		b.WriteString(" (" + strings.Join(columns, ", ") + ")")
+	}
+	return b.String()
+}
+
+// EncodeLoadData writes rows to w in LoadDataTSV format
+func EncodeLoadData(w io.Writer, rows RowIterator) error {
+	bw := bufio.NewWriter(w)
+	var buf []byte
+	for {
+		row, err := rows()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		buf = buf[:0]
+		for i, v := range row {
+			if i > 0 {
+				buf = append(buf, '\t')
+			}
+			buf, err = appendLoadDataValue(buf, v)
+			if err != nil {
+				return err
+			}
+		}
+		buf = append(buf, '\n')
+		_, err = bw.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func appendLoadDataValue(buf []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(buf, `\N`...), nil
+	case string:
+		return appendLoadDataEscape(buf, v), nil
+	case []byte:
+		if v == nil {
+			return append(buf, `\N`...), nil
+		}
+		return appendLoadDataEscape(buf, string(v)), nil
+	case bool:
+		if v {
+			return append(buf, '1'), nil
+		}
+		return append(buf, '0'), nil
+	case int:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int8:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(buf, v, 10), nil
+	case uint:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint8:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(buf, v, 10), nil
+	case float32:
+		return appendLoadDataFloat(buf, float64(v), 32)
+	case float64:
+		return appendLoadDataFloat(buf, v, 64)
+	case time.Time:
+		if v.IsZero() {
+			return append(buf, "0000-00-00"...), nil
+		}
+		return v.AppendFormat(buf, "2006-01-02 15:04:05.999999"), nil
+	case driver.Valuer:
+		x, err := v.Value()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := x.(driver.Valuer); ok {
+			return nil, fmt.Errorf("mysql: load data not support value %T", v)
+		}
+		return appendLoadDataValue(buf, x)
+	default:
+		return nil, fmt.Errorf("mysql: load data not support value %T", v)
+	}
+}
+
+func appendLoadDataFloat(buf []byte, v float64, bitSize int) ([]byte, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("mysql: load data not support value %v", v)
+	}
+	return strconv.AppendFloat(buf, v, 'g', -1, bitSize), nil
+}
+
+func appendLoadDataEscape(buf []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case 0:
+			buf = append(buf, '\\', '0')
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return buf
+}
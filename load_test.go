@@ -0,0 +1,180 @@
+package mysql_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql"
+)
+
+func TestEncodeLoadData(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := mysql.EncodeLoadData(&buf, mysql.RowsOf([][]interface{}{
+		{1, "a\tb\nc\\d\re\x00", nil, true},
+		{int64(-2), []byte(`\N`), 1.5, false},
+		{uint8(3), mysql.NullString(new(string)), time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.UTC), "NULL"},
+		{4, []byte(nil), []byte{}, float32(0.5)},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"1\ta\\tb\\nc\\\\d\\re\\0\t\\N\t1\n"+
+			"-2\t\\\\N\t1.5\t0\n"+
+			"3\t\\N\t2020-01-02 03:04:05.6\tNULL\n"+
+			"4\t\\N\t\t0.5\n",
+		buf.String(),
+	)
+
+	err = mysql.EncodeLoadData(io.Discard, mysql.RowsOf([][]interface{}{
+		{struct{}{}},
+	}))
+	assert.Error(t, err)
+
+	for _, v := range []interface{}{math.NaN(), math.Inf(1), float32(math.Inf(-1))} {
+		err = mysql.EncodeLoadData(io.Discard, mysql.RowsOf([][]interface{}{
+			{v},
+		}))
+		assert.Error(t, err, v)
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Rows", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`^load data local infile 'Reader::mysql_load_data_\d+' into table users character set utf8mb4 fields terminated by X'09' escaped by X'5C' lines terminated by X'0A' \(id, name\)$`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery("^show warnings$").
+			WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}).
+				AddRow("Warning", 1265, "Data truncated for column 'name' at row 2"))
+
+		res, err := mysql.LoadData(context.Background(), db, "users", []string{"id", "name"}, mysql.RowsOf([][]interface{}{
+			{1, "a"},
+			{2, "b"},
+		}), nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, res) {
+			assert.Equal(t, int64(2), res.RowsAffected)
+			assert.Equal(t, []mysql.Warning{
+				{Level: "Warning", Code: 1265, Message: "Data truncated for column 'name' at row 2"},
+			}, res.Warnings)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reader CSV", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`^load data local infile 'Reader::mysql_load_data_\d+' replace into table users character set latin1 fields terminated by ',' optionally enclosed by '"' escaped by '' lines terminated by X'0A' ignore 1 lines$`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("^show warnings$").
+			WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+
+		res, err := mysql.LoadDataReader(context.Background(), db, "users", nil, strings.NewReader("id,name\n1,a\n"), &mysql.LoadDataOptions{
+			Format:       mysql.LoadDataCSV,
+			CharacterSet: "latin1",
+			Replace:      true,
+			IgnoreLines:  1,
+		})
+		assert.NoError(t, err)
+		if assert.NotNil(t, res) {
+			assert.Equal(t, int64(1), res.RowsAffected)
+			assert.Empty(t, res.Warnings)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Iterator error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("^load data").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("^show warnings$").
+			WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+
+		retErr := fmt.Errorf("error")
+		_, err = mysql.LoadData(context.Background(), db, "users", nil, func() ([]interface{}, error) {
+			return nil, retErr
+		}, nil)
+		assert.Equal(t, retErr, err)
+	})
+}
+
+// readerHandlerDB reads load data through registered reader handler like the driver
+type readerHandlerDB struct {
+	db       *sql.DB
+	handlers map[string]func() io.Reader
+	data     []byte
+}
+
+func (db *readerHandlerDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	name := strings.TrimPrefix(query, "load data local infile 'Reader::")
+	name = name[:strings.IndexByte(name, '\'')]
+	handler, ok := db.handlers[name]
+	if !ok {
+		return nil, fmt.Errorf("reader %s is not registered", name)
+	}
+
+	var err error
+	db.data, err = io.ReadAll(handler())
+	if err != nil {
+		return nil, err
+	}
+	return sqlmock.NewResult(0, int64(bytes.Count(db.data, []byte("\n")))), nil
+}
+
+func (db *readerHandlerDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return db.db.QueryContext(ctx, query, args...)
+}
+
+func TestLoadDataReaderHandler(t *testing.T) {
+	mdb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mdb.Close()
+
+	mock.ExpectQuery("^show warnings$").
+		WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+
+	db := readerHandlerDB{
+		db:       mdb,
+		handlers: make(map[string]func() io.Reader),
+	}
+	defer mysql.SetReaderHandler(
+		func(name string, handler func() io.Reader) { db.handlers[name] = handler },
+		func(name string) { delete(db.handlers, name) },
+	)()
+
+	res, err := mysql.LoadData(context.Background(), &db, "users", []string{"id", "name", "note"}, mysql.RowsOf([][]interface{}{
+		{1, "a\tb", nil},
+		{2, "c\\N\nd", "NULL"},
+	}), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, res) {
+		assert.Equal(t, int64(2), res.RowsAffected)
+	}
+	assert.Equal(t,
+		"1\ta\\tb\t\\N\n"+
+			"2\tc\\\\N\\nd\tNULL\n",
+		string(db.data),
+	)
+	assert.Empty(t, db.handlers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
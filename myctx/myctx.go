@@ -101,13 +101,54 @@ func Committed(ctx context.Context, f func(ctx context.Context)) {
 	pTx.onCommitted = append(pTx.onCommitted, f)
 }
 
+// WithStmtCache uses cached prepared statements for QueryRow, Query, Exec and Iter,
+// cache must be created from the same db as in context.
+// Inside RunInTx, statements bind to tx with tx.StmtContext, see StmtCache.PrepareInTx
+func WithStmtCache(ctx context.Context, cache *mysql.StmtCache) context.Context {
+	return context.WithValue(ctx, ctxKeyStmtCache{}, cache)
+}
+
 type (
-	ctxKeyDB      struct{}
-	ctxKeyQueryer struct{}
+	ctxKeyDB        struct{}
+	ctxKeyQueryer   struct{}
+	ctxKeyStmtCache struct{}
 )
 
 func q(ctx context.Context) Queryer {
-	return ctx.Value(ctxKeyQueryer{}).(Queryer)
+	q := ctx.Value(ctxKeyQueryer{}).(Queryer)
+	cache, _ := ctx.Value(ctxKeyStmtCache{}).(*mysql.StmtCache)
+	if cache == nil {
+		return q
+	}
+	if tx, ok := q.(*wrapTx); ok {
+		return &stmtCacheQueryer{q, cache.Tx(tx.Tx)}
+	}
+	return &stmtCacheQueryer{q, cache}
+}
+
+type cachedQueryer interface {
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+}
+
+// stmtCacheQueryer runs queries with cached statements,
+// and prepares with underlying queryer
+type stmtCacheQueryer struct {
+	Queryer
+	cache cachedQueryer
+}
+
+func (q *stmtCacheQueryer) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return q.cache.QueryRowContext(ctx, query, args...)
+}
+
+func (q *stmtCacheQueryer) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return q.cache.QueryContext(ctx, query, args...)
+}
+
+func (q *stmtCacheQueryer) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return q.cache.ExecContext(ctx, query, args...)
 }
 
 // QueryRow calls db.QueryRowContext
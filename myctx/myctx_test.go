@@ -163,3 +163,39 @@ func TestCommitted(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestWithStmtCache(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cache := mysql.NewStmtCache(db, 10)
+	defer cache.Close()
+
+	ctx := myctx.NewContext(context.Background(), db)
+	ctx = myctx.WithStmtCache(ctx, cache)
+
+	p := mock.ExpectPrepare("select 1")
+	p.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 0))
+	p.ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow(1))
+	mock.ExpectBegin()
+	p.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	_, err = myctx.Exec(ctx, "select 1")
+	assert.NoError(t, err)
+	err = myctx.Iter(ctx, func(scan mysql.Scanner) error {
+		var a int
+		return scan(&a)
+	}, "select 1")
+	assert.NoError(t, err)
+	err = myctx.RunInTx(ctx, func(ctx context.Context) error {
+		_, err := myctx.Exec(ctx, "select 1")
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cache.Len())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -66,6 +66,15 @@ func TestDo_SelectModel(t *testing.T) {
 		}
 	}
 
+	{
+		var m selectModel
+		err = myctx.RunInTx(ctx, func(ctx context.Context) error {
+			return mymodel.Do(ctx, &m, mymodel.Equal("id", 1), mymodel.ForUpdate())
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), m.ID)
+	}
+
 	{
 		var m selectModel
 		err = mymodel.Do(ctx, &m, filterError{})
@@ -9,9 +9,11 @@ import (
 type Cond interface {
 	Where(f func(b mystmt.Cond))
 	Having(f func(b mystmt.Cond))
-	OrderBy(col string) mystmt.OrderBy
+	OrderBy(col interface{}) mystmt.OrderBy
 	Limit(n int64)
 	Offset(n int64)
+	ForUpdate() mystmt.Lock
+	ForShare() mystmt.Lock
 }
 
 type Filter interface {
@@ -63,18 +65,60 @@ func Offset(n int64) Filter {
 	})
 }
 
+// LockOption is the locking read option for ForUpdate and ForShare
+type LockOption func(l mystmt.Lock)
+
+// LockOf locks only rows from tables
+func LockOf(table ...string) LockOption {
+	return func(l mystmt.Lock) { l.Of(table...) }
+}
+
+// NoWait returns error instead of waiting for locked rows
+func NoWait() LockOption {
+	return func(l mystmt.Lock) { l.NoWait() }
+}
+
+// SkipLocked skips locked rows
+func SkipLocked() LockOption {
+	return func(l mystmt.Lock) { l.SkipLocked() }
+}
+
+func ForUpdate(opts ...LockOption) Filter {
+	return FilterFunc(func(_ context.Context, b Cond) error {
+		applyLock(b.ForUpdate(), opts)
+		return nil
+	})
+}
+
+func ForShare(opts ...LockOption) Filter {
+	return FilterFunc(func(_ context.Context, b Cond) error {
+		applyLock(b.ForShare(), opts)
+		return nil
+	})
+}
+
+func applyLock(l mystmt.Lock, opts []LockOption) {
+	for _, opt := range opts {
+		opt(l)
+	}
+}
+
 type condUpdateWrapper struct {
 	mystmt.UpdateStatement
 }
 
 func (c condUpdateWrapper) Having(f func(b mystmt.Cond)) {}
 
-func (c condUpdateWrapper) OrderBy(col string) mystmt.OrderBy { return noopOrderBy{} }
+func (c condUpdateWrapper) OrderBy(col interface{}) mystmt.OrderBy { return noopOrderBy{} }
 
 func (c condUpdateWrapper) Limit(n int64) {}
 
 func (c condUpdateWrapper) Offset(n int64) {}
 
+func (c condUpdateWrapper) ForUpdate() mystmt.Lock { return noopLock{} }
+
+func (c condUpdateWrapper) ForShare() mystmt.Lock { return noopLock{} }
+
 type noopOrderBy struct{}
 
 func (n noopOrderBy) Asc() mystmt.OrderBy { return n }
@@ -84,3 +128,11 @@ func (n noopOrderBy) Desc() mystmt.OrderBy { return n }
 func (n noopOrderBy) NullsFirst() mystmt.OrderBy { return n }
 
 func (n noopOrderBy) NullsLast() mystmt.OrderBy { return n }
+
+type noopLock struct{}
+
+func (n noopLock) Of(table ...string) mystmt.Lock { return n }
+
+func (n noopLock) NoWait() mystmt.Lock { return n }
+
+func (n noopLock) SkipLocked() mystmt.Lock { return n }
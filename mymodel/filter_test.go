@@ -0,0 +1,40 @@
+package mymodel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mymodel"
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestLockFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		filter mymodel.Filter
+		query  string
+	}{
+		{"for update", mymodel.ForUpdate(), "select * from jobs for update"},
+		{"for update skip locked", mymodel.ForUpdate(mymodel.SkipLocked()), "select * from jobs for update skip locked"},
+		{"for update of nowait", mymodel.ForUpdate(mymodel.LockOf("jobs"), mymodel.NoWait()), "select * from jobs for update of jobs nowait"},
+		{"for share", mymodel.ForShare(), "select * from jobs for share"},
+		{"for share nowait", mymodel.ForShare(mymodel.NoWait()), "select * from jobs for share nowait"},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			r := mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("jobs")
+				assert.NoError(t, tC.filter.Apply(context.Background(), b))
+			})
+			q, _ := r.SQL()
+			assert.Equal(t, tC.query, q)
+			assert.NoError(t, r.Err())
+		})
+	}
+}
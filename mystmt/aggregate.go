@@ -0,0 +1,85 @@
+package mystmt
+
+// Grouping builds grouping(col, ...) expression,
+// use with GroupBy(...).WithRollup() to detect super-aggregate rows
+func Grouping(col ...interface{}) Expr {
+	return Func("grouping", col...)
+}
+
+// CountDistinct builds count(distinct x, ...) expression
+func CountDistinct(x ...interface{}) Expr {
+	var p parenGroup
+	p.prefix = "count"
+	p.push(withGroup(" ", "distinct", withGroup(", ", x...)))
+	return newExpr(&p)
+}
+
+// JSONArrayAgg builds json_arrayagg(x) expression
+func JSONArrayAgg(x interface{}) Expr {
+	return Func("json_arrayagg", x)
+}
+
+// JSONObjectAgg builds json_objectagg(key, value) expression
+func JSONObjectAgg(key, value interface{}) Expr {
+	return Func("json_objectagg", key, value)
+}
+
+// GroupConcat builds group_concat expression
+func GroupConcat(f func(b GroupConcatExpr)) Expr {
+	var x groupConcat
+	f(&x)
+	return &x
+}
+
+// GroupConcatExpr is the group_concat expression builder,
+// values are not marked as argument, use Arg to mark value as argument
+type GroupConcatExpr interface {
+	Distinct()
+	Columns(x ...interface{})
+	OrderBy(col interface{}) OrderBy
+	Separator(sep string)
+}
+
+type groupConcat struct {
+	distinct  bool
+	columns   group
+	orderBy   group
+	separator *string
+}
+
+func (st *groupConcat) Distinct() {
+	st.distinct = true
+}
+
+func (st *groupConcat) Columns(x ...interface{}) {
+	st.columns.push(x...)
+}
+
+func (st *groupConcat) OrderBy(col interface{}) OrderBy {
+	p := orderBy{
+		col: col,
+	}
+	st.orderBy.push(&p)
+	return &p
+}
+
+func (st *groupConcat) Separator(sep string) {
+	st.separator = &sep
+}
+
+func (st *groupConcat) build() []interface{} {
+	var p parenGroup
+	p.prefix = "group_concat"
+	p.sep = " "
+	if st.distinct {
+		p.push("distinct")
+	}
+	p.push(&st.columns)
+	if !st.orderBy.empty() {
+		p.push("order by", &st.orderBy)
+	}
+	if st.separator != nil {
+		p.push("separator", string(appendQuote(nil, *st.separator)))
+	}
+	return []interface{}{&p}
+}
@@ -0,0 +1,83 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rollup", func(t *testing.T) {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(
+				"year",
+				"country",
+				mystmt.As(mystmt.Func("sum", "profit"), "profit"),
+				mystmt.As(mystmt.Grouping("year", "country"), "g"),
+			)
+			b.From("sales")
+			b.Where(func(b mystmt.Cond) {
+				b.Gt("year", 2000)
+			})
+			b.GroupBy("year", "country").WithRollup()
+			b.Having(func(b mystmt.Cond) {
+				b.Gt(mystmt.Func("sum", "profit"), 0)
+			})
+		}).SQL()
+
+		assert.Equal(t,
+			stripSpace(`
+				select year, country, sum(profit) profit, grouping(year, country) g
+				from sales
+				where (year > ?)
+				group by year, country with rollup
+				having (sum(profit) > ?)
+			`),
+			q,
+		)
+		assert.EqualValues(t, []interface{}{2000, 0}, args)
+	})
+
+	t.Run("aggregates", func(t *testing.T) {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(
+				"user_id",
+				mystmt.CountDistinct("product_id"),
+				mystmt.CountDistinct("product_id", "variant_id"),
+				mystmt.GroupConcat(func(b mystmt.GroupConcatExpr) {
+					b.Distinct()
+					b.Columns("name")
+					b.OrderBy("name").Desc()
+					b.Separator("; ")
+				}),
+				mystmt.GroupConcat(func(b mystmt.GroupConcatExpr) {
+					b.Columns("id", mystmt.Arg("-"), "name")
+				}),
+				mystmt.JSONArrayAgg("product_id"),
+				mystmt.JSONObjectAgg(mystmt.Func("concat", mystmt.Arg("p"), "product_id"), "qty"),
+			)
+			b.From("orders")
+			b.GroupBy("user_id")
+		}).SQL()
+
+		assert.Equal(t,
+			stripSpace(`
+				select user_id,
+					count(distinct product_id),
+					count(distinct product_id, variant_id),
+					group_concat(distinct name order by name desc separator '; '),
+					group_concat(id, ?, name),
+					json_arrayagg(product_id),
+					json_objectagg(concat(?, product_id), qty)
+				from orders
+				group by user_id
+			`),
+			q,
+		)
+		assert.EqualValues(t, []interface{}{"-", "p"}, args)
+	})
+}
@@ -1,6 +1,7 @@
 package mystmt
 
-// Arg marks value as argument to replace with ? when build query
+// Arg marks value as argument to replace with ? when build query,
+// expression will not mark as argument
 func Arg(v interface{}) interface{} {
 	switch v.(type) {
 	default:
@@ -8,6 +9,8 @@ func Arg(v interface{}) interface{} {
 	case arg:
 	case notArg:
 	case defaultValue:
+	case param:
+	case builder:
 	}
 	return v
 }
@@ -16,7 +19,9 @@ type arg struct {
 	value interface{}
 }
 
-// NotArg marks value as non-argument
+// NotArg marks value as non-argument, value will render as escaped literal.
+//
+// Unsupported value returns error from Result.Err
 func NotArg(v interface{}) interface{} {
 	if _, ok := v.(notArg); ok {
 		return v
@@ -18,15 +18,6 @@ func (b *buffer) pushFront(q ...interface{}) {
 	b.q = append(q, b.q...)
 }
 
-func (b *buffer) popFront() interface{} {
-	if b.empty() {
-		return nil
-	}
-	p := b.q[0]
-	b.q = b.q[1:]
-	return p
-}
-
 func (b *buffer) empty() bool {
 	return len(b.q) == 0
 }
@@ -39,56 +30,134 @@ type builder interface {
 	build() []interface{}
 }
 
-func build(b *buffer) (string, []interface{}) {
-	var args []interface{}
-	var i int
-
-	var f func(p []interface{}, sep string) string
-	f = func(p []interface{}, sep string) string {
-		var q []string
-		for _, x := range p {
-			switch x := x.(type) {
-			default:
-				q = append(q, convertToString(x))
-			case builder:
-				q = append(q, f(x.build(), " "))
-			case arg:
-				i++
-				q = append(q, "?")
-				args = append(args, x.value)
-			case *group:
-				if !x.empty() {
-					q = append(q, f(x.q, x.getSep()))
-				}
-			case *parenGroup:
-				if !x.empty() {
-					q = append(q, x.prefix+"("+f(x.q, x.getSep())+")")
-				}
+// errNode records invalid statement, build returns the first error
+type errNode struct {
+	err error
+}
+
+func errorf(format string, a ...interface{}) errNode {
+	return errNode{fmt.Errorf(format, a...)}
+}
+
+func build(b *buffer) (string, []interface{}, error) {
+	var r renderer
+	r.sb.Grow(256)
+	r.write(b.q, " ")
+	return r.sb.String(), r.args, r.err
+}
+
+// renderer writes query into single buffer
+type renderer struct {
+	sb   strings.Builder
+	args []interface{}
+	err  error
+}
+
+// addArg appends argument, allocates args on first argument
+func (r *renderer) addArg(v interface{}) {
+	if r.args == nil {
+		r.args = make([]interface{}, 0, 8)
+	}
+	r.args = append(r.args, v)
+}
+
+func (r *renderer) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// write writes p separated by sep, empty groups are skipped
+func (r *renderer) write(p []interface{}, sep string) {
+	first := true
+	next := func() {
+		if !first {
+			r.sb.WriteString(sep)
+		}
+		first = false
+	}
+
+	for _, x := range p {
+		switch x := x.(type) {
+		default:
+			next()
+			r.writeValue(x)
+		case builder:
+			next()
+			r.write(x.build(), " ")
+		case arg:
+			next()
+			r.sb.WriteByte('?')
+			r.addArg(x.value)
+		case param:
+			next()
+			r.sb.WriteByte('?')
+			r.addArg(x)
+		case errNode:
+			r.setErr(x.err)
+		case *group:
+			if !x.empty() {
+				next()
+				r.write(x.q, x.getSep())
+			}
+		case *concatGroup:
+			if !x.empty() {
+				next()
+				r.write(x.q, "")
+			}
+		case *parenGroup:
+			if !x.empty() {
+				next()
+				r.sb.WriteString(x.prefix)
+				r.sb.WriteByte('(')
+				r.write(x.q, x.getSep())
+				r.sb.WriteByte(')')
 			}
 		}
-		return strings.Join(q, sep)
 	}
-	query := f(b.q, " ")
-	return query, args
 }
 
-func convertToString(x interface{}) string {
+func (r *renderer) writeValue(x interface{}) {
+	var buf [32]byte
 	switch x := x.(type) {
 	default:
-		return fmt.Sprint(x)
+		fmt.Fprint(&r.sb, x)
 	case string:
-		return x
+		r.sb.WriteString(x)
 	case int:
-		return strconv.Itoa(x)
+		r.sb.Write(strconv.AppendInt(buf[:0], int64(x), 10))
+	case int8:
+		r.sb.Write(strconv.AppendInt(buf[:0], int64(x), 10))
+	case int16:
+		r.sb.Write(strconv.AppendInt(buf[:0], int64(x), 10))
 	case int32:
-		return strconv.FormatInt(int64(x), 10)
+		r.sb.Write(strconv.AppendInt(buf[:0], int64(x), 10))
 	case int64:
-		return strconv.FormatInt(x, 10)
+		r.sb.Write(strconv.AppendInt(buf[:0], x, 10))
+	case uint:
+		r.sb.Write(strconv.AppendUint(buf[:0], uint64(x), 10))
+	case uint8:
+		r.sb.Write(strconv.AppendUint(buf[:0], uint64(x), 10))
+	case uint16:
+		r.sb.Write(strconv.AppendUint(buf[:0], uint64(x), 10))
+	case uint32:
+		r.sb.Write(strconv.AppendUint(buf[:0], uint64(x), 10))
+	case uint64:
+		r.sb.Write(strconv.AppendUint(buf[:0], x, 10))
+	case float32:
+		r.sb.Write(strconv.AppendFloat(buf[:0], float64(x), 'g', -1, 32))
+	case float64:
+		r.sb.Write(strconv.AppendFloat(buf[:0], x, 'g', -1, 64))
 	case bool:
-		return strconv.FormatBool(x)
+		r.sb.WriteString(strconv.FormatBool(x))
 	case notArg:
-		return convertToString(x.value)
+		b, err := appendLiteral(buf[:0], x.value)
+		if err != nil {
+			r.setErr(err)
+			return
+		}
+		r.sb.Write(b)
 	case defaultValue:
-		return "default"
+		r.sb.WriteString("default")
 	}
 }
@@ -0,0 +1,75 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestBuildValue(t *testing.T) {
+	t.Parallel()
+
+	q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns(1, int8(-2), uint16(3), uint64(4), 1.5, float32(0.1), true, mystmt.NotArg(5))
+		b.From("users")
+		b.Limit(10)
+	}).SQL()
+
+	assert.Equal(t, "select 1, -2, 3, 4, 1.5, 0.1, true, 5 from users limit 10", q)
+	assert.Empty(t, args)
+}
+
+func BenchmarkSelect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("id", "name", "email", "created_at")
+			b.From("users")
+			b.LeftJoin("profiles").On(func(b mystmt.Cond) {
+				b.EqRaw("profiles.user_id", "users.id")
+			})
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("tenant_id", 1)
+				b.IsNull("deleted_at")
+				b.Or(func(b mystmt.Cond) {
+					b.Eq("role", "admin")
+					b.Gt("level", 10)
+				})
+			})
+			b.OrderBy("created_at").Desc()
+			b.Limit(10)
+			b.Offset(20)
+		}).SQL()
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mystmt.Insert(func(b mystmt.InsertStatement) {
+			b.Into("users")
+			b.Columns("username", "name", "email", "created_at")
+			for j := 0; j < 10; j++ {
+				b.Value("tester", "Tester", "tester@localhost", mystmt.Default)
+			}
+		}).SQL()
+	}
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mystmt.Update(func(b mystmt.UpdateStatement) {
+			b.Table("users")
+			b.Set("name").To("Tester")
+			b.Set("email").To("tester@localhost")
+			b.Set("updated_at").ToRaw("now()")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("id", 1)
+				b.Eq("tenant_id", 2)
+			})
+		}).SQL()
+	}
+}
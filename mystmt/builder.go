@@ -0,0 +1,42 @@
+package mystmt
+
+// Builder is the reusable statement builder,
+// it can be cloned, extended and built many times.
+//
+// Builder stores f and calls all f on new statement on every Build,
+// f should not have side effects
+type Builder[T any] struct {
+	newStmt func() (T, statement)
+	fs      []func(b T)
+}
+
+type statement interface {
+	make() *buffer
+}
+
+func newBuilder[T any](newStmt func() (T, statement), f []func(b T)) *Builder[T] {
+	return (&Builder[T]{newStmt: newStmt}).Apply(f...)
+}
+
+// Apply extends statement with f
+func (b *Builder[T]) Apply(f ...func(b T)) *Builder[T] {
+	b.fs = append(b.fs, f...)
+	return b
+}
+
+// Clone returns copy of builder, extends the copy will not modify b
+func (b *Builder[T]) Clone() *Builder[T] {
+	return &Builder[T]{
+		newStmt: b.newStmt,
+		fs:      append([]func(b T){}, b.fs...),
+	}
+}
+
+// Build builds statement
+func (b *Builder[T]) Build() *Result {
+	x, st := b.newStmt()
+	for _, f := range b.fs {
+		f(x)
+	}
+	return newResult(build(st.make()))
+}
@@ -0,0 +1,106 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("select", func(t *testing.T) {
+		base := mystmt.NewSelect(func(b mystmt.SelectStatement) {
+			b.From("orders o")
+			b.Join("users u").On(func(b mystmt.Cond) {
+				b.EqRaw("u.id", "o.user_id")
+			})
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("o.tenant_id", 1)
+			})
+		})
+
+		count := base.Clone().Apply(func(b mystmt.SelectStatement) {
+			b.Columns("count(*)")
+		})
+		page := base.Clone().Apply(func(b mystmt.SelectStatement) {
+			b.Columns("o.id", "u.name")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("o.status", "paid")
+			})
+			b.OrderBy("o.id").Desc()
+			b.Limit(10)
+		})
+
+		q, args := count.Build().SQL()
+		assert.Equal(t, "select count(*) from orders o join users u on (u.id = o.user_id) where (o.tenant_id = ?)", q)
+		assert.EqualValues(t, []interface{}{1}, args)
+
+		q, args = page.Build().SQL()
+		assert.Equal(t, "select o.id, u.name from orders o join users u on (u.id = o.user_id) where (o.tenant_id = ? and o.status = ?) order by o.id desc limit 10", q)
+		assert.EqualValues(t, []interface{}{1, "paid"}, args)
+
+		// build many times
+		q2, args2 := page.Build().SQL()
+		assert.Equal(t, q, q2)
+		assert.Equal(t, args, args2)
+
+		// base is not modified
+		q, _ = base.Clone().Apply(func(b mystmt.SelectStatement) {
+			b.Columns("o.id")
+		}).Build().SQL()
+		assert.Equal(t, "select o.id from orders o join users u on (u.id = o.user_id) where (o.tenant_id = ?)", q)
+	})
+
+	t.Run("update delete", func(t *testing.T) {
+		filter := func(b mystmt.Cond) {
+			b.Eq("tenant_id", 1)
+			b.IsNotNull("deleted_at")
+		}
+
+		q, args := mystmt.NewUpdate(func(b mystmt.UpdateStatement) {
+			b.Table("users")
+			b.Set("name").To("x")
+		}).Apply(func(b mystmt.UpdateStatement) {
+			b.Where(filter)
+		}).Build().SQL()
+		assert.Equal(t, "update users set name = ? where (tenant_id = ? and deleted_at is not null)", q)
+		assert.EqualValues(t, []interface{}{"x", 1}, args)
+
+		del := mystmt.NewDelete(func(b mystmt.DeleteStatement) {
+			b.From("users")
+			b.Where(filter)
+		})
+		q, args = del.Clone().Apply(func(b mystmt.DeleteStatement) {
+			b.Limit(100)
+		}).Build().SQL()
+		assert.Equal(t, "delete from users where (tenant_id = ? and deleted_at is not null) limit 100", q)
+		assert.EqualValues(t, []interface{}{1}, args)
+	})
+
+	t.Run("insert union", func(t *testing.T) {
+		q, args := mystmt.NewInsert(func(b mystmt.InsertStatement) {
+			b.Into("users")
+			b.Columns("name")
+		}).Apply(func(b mystmt.InsertStatement) {
+			b.Value("a")
+		}).Build().SQL()
+		assert.Equal(t, "insert into users (name) values (?)", q)
+		assert.EqualValues(t, []interface{}{"a"}, args)
+
+		q, _ = mystmt.NewUnion(func(b mystmt.UnionStatement) {
+			b.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("t1")
+			})
+		}).Apply(func(b mystmt.UnionStatement) {
+			b.AllSelect(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("t2")
+			})
+		}).Build().SQL()
+		assert.Equal(t, "(select id from t1) union all (select id from t2)", q)
+	})
+}
@@ -0,0 +1,134 @@
+package mystmt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Param marks value as named parameter to bind when execute compiled statement,
+// executing Result with params returns error, use Compile and Bind instead
+func Param(name string) interface{} {
+	return param{name}
+}
+
+type param struct {
+	name string
+}
+
+// Compiled is the compiled statement,
+// it can bind parameters many times without rebuild the statement
+type Compiled struct {
+	query  string
+	args   []interface{}
+	params []compiledParam
+	err    error
+}
+
+type compiledParam struct {
+	index int
+	name  string
+}
+
+// Compile compiles result into reusable statement
+func (r *Result) Compile() *Compiled {
+	c := Compiled{
+		query: r.query,
+		args:  r.args,
+		err:   r.err,
+	}
+	for i, v := range r.args {
+		if p, ok := v.(param); ok {
+			c.params = append(c.params, compiledParam{i, p.name})
+		}
+	}
+	return &c
+}
+
+// Query returns compiled query
+func (c *Compiled) Query() string {
+	return c.query
+}
+
+// Bind returns arguments from params,
+// params can be map[string]interface{} or struct (or pointer to struct).
+//
+// Struct field matches by `param` tag, or field name (case insensitive).
+func (c *Compiled) Bind(params interface{}) ([]interface{}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	args := make([]interface{}, len(c.args))
+	copy(args, c.args)
+	if len(c.params) == 0 {
+		return args, nil
+	}
+
+	switch p := params.(type) {
+	case map[string]interface{}:
+		for _, x := range c.params {
+			v, ok := p[x.name]
+			if !ok {
+				return nil, fmt.Errorf("mystmt: missing param %s", x.name)
+			}
+			args[x.index] = v
+		}
+		return args, nil
+	}
+
+	rv := reflect.ValueOf(params)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("mystmt: bind not support params %T", params)
+	}
+	fields := structParams(rv.Type())
+	for _, x := range c.params {
+		index, ok := fields[strings.ToLower(x.name)]
+		if !ok {
+			return nil, fmt.Errorf("mystmt: missing param %s", x.name)
+		}
+		f, err := rv.FieldByIndexErr(index)
+		if err != nil {
+			return nil, fmt.Errorf("mystmt: param %s; %w", x.name, err)
+		}
+		args[x.index] = f.Interface()
+	}
+	return args, nil
+}
+
+// MustBind likes Bind but panic on error
+func (c *Compiled) MustBind(params interface{}) []interface{} {
+	args, err := c.Bind(params)
+	if err != nil {
+		panic(err)
+	}
+	return args
+}
+
+var structParamsCache sync.Map // map[reflect.Type]map[string][]int
+
+// structParams returns lower case param name to field index
+func structParams(t reflect.Type) map[string][]int {
+	if m, ok := structParamsCache.Load(t); ok {
+		return m.(map[string][]int)
+	}
+
+	m := make(map[string][]int)
+	// field names first, tags override field names
+	for _, f := range reflect.VisibleFields(t) {
+		if f.IsExported() && !f.Anonymous {
+			m[strings.ToLower(f.Name)] = f.Index
+		}
+	}
+	for _, f := range reflect.VisibleFields(t) {
+		if name := f.Tag.Get("param"); f.IsExported() && name != "" && name != "-" {
+			m[strings.ToLower(name)] = f.Index
+		}
+	}
+	structParamsCache.Store(t, m)
+	return m
+}
@@ -0,0 +1,152 @@
+package mystmt_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	c := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns("id", "name")
+		b.From("users")
+		b.Where(func(b mystmt.Cond) {
+			b.Eq("tenant_id", mystmt.Param("tenant"))
+			b.Eq("is_active", true)
+			b.Raw("created_at > ?", mystmt.Param("since"))
+			b.Eq("owner_id", mystmt.Param("tenant"))
+		})
+		b.Limit(10)
+	}).Compile()
+
+	assert.Equal(t,
+		"select id, name from users where (tenant_id = ? and is_active = ? and created_at > ? and owner_id = ?) limit 10",
+		c.Query(),
+	)
+
+	t.Run("Map", func(t *testing.T) {
+		args, err := c.Bind(map[string]interface{}{
+			"tenant": 1,
+			"since":  "2020-01-01",
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []interface{}{1, true, "2020-01-01", 1}, args)
+
+		args, err = c.Bind(map[string]interface{}{
+			"tenant": 2,
+			"since":  "2021-01-01",
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []interface{}{2, true, "2021-01-01", 2}, args)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		type base struct {
+			Since string
+		}
+		type params struct {
+			base
+			TenantID int64 `param:"tenant"`
+		}
+
+		args, err := c.Bind(&params{base{"2020-01-01"}, 3})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []interface{}{int64(3), true, "2020-01-01", int64(3)}, args)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := c.Bind(map[string]interface{}{"tenant": 1})
+		assert.Error(t, err)
+
+		_, err = c.Bind(struct{ Since string }{})
+		assert.Error(t, err)
+
+		_, err = c.Bind(1)
+		assert.Error(t, err)
+
+		assert.Panics(t, func() {
+			c.MustBind(nil)
+		})
+	})
+
+	t.Run("Without params", func(t *testing.T) {
+		c := mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.From("users")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("id", 1)
+			})
+		}).Compile()
+
+		assert.EqualValues(t, []interface{}{1}, c.MustBind(nil))
+	})
+
+	t.Run("Exec without Compile", func(t *testing.T) {
+		r := mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.From("users")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("id", mystmt.Param("id"))
+			})
+		})
+		assert.NoError(t, r.Err())
+
+		called := false
+		_, err := r.Exec(func(string, ...interface{}) (sql.Result, error) {
+			called = true
+			return nil, nil
+		})
+		assert.EqualError(t, err, "mystmt: param id is not bound, use Compile and Bind")
+		assert.False(t, called)
+	})
+}
+
+func benchmarkSelect(id, tenant interface{}) *mystmt.Result {
+	return mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns("id", "name", "email", "created_at")
+		b.From("users")
+		b.LeftJoin("profiles p").On(func(b mystmt.Cond) {
+			b.EqRaw("p.user_id", "users.id")
+		})
+		b.Where(func(b mystmt.Cond) {
+			b.Eq("users.id", id)
+			b.Eq("users.tenant_id", tenant)
+			b.IsNull("users.deleted_at")
+		})
+		b.OrderBy("created_at").Desc()
+		b.Limit(1)
+	})
+}
+
+func BenchmarkSelectRebuild(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkSelect(i, 1).SQL()
+	}
+}
+
+func BenchmarkSelectCompiledBind(b *testing.B) {
+	c := benchmarkSelect(mystmt.Param("id"), mystmt.Param("tenant")).Compile()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.MustBind(map[string]interface{}{"id": i, "tenant": 1})
+	}
+}
+
+func BenchmarkSelectCompiledBindStruct(b *testing.B) {
+	type params struct {
+		ID     int
+		Tenant int
+	}
+
+	c := benchmarkSelect(mystmt.Param("id"), mystmt.Param("tenant")).Compile()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.MustBind(&params{i, 1})
+	}
+}
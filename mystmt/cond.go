@@ -1,33 +1,74 @@
 package mystmt
 
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
 // Cond is the condition builder
 type Cond interface {
-	Op(field, op string, value interface{})
-	OpRaw(field, op string, rawValue interface{})
-	Eq(field string, value interface{})
-	EqRaw(field string, rawValue interface{})
-	Ne(field string, value interface{})
-	NeRaw(field string, rawValue interface{})
-	Lt(field string, value interface{})
-	LtRaw(field string, rawValue interface{})
-	Le(field string, value interface{})
-	LeRaw(field string, rawValue interface{})
-	Gt(field string, value interface{})
-	GtRaw(field string, rawValue interface{})
-	Ge(field string, value interface{})
-	GeRaw(field string, rawValue interface{})
-	Like(field string, value interface{})
-	LikeRaw(field string, rawValue interface{})
-	In(field string, value ...interface{})
-	InRaw(field string, value ...interface{})
-	InSelect(field string, f func(b SelectStatement))
-	NotIn(field string, value ...interface{})
-	NotInRaw(field string, value ...interface{})
-	IsNull(field string)
-	IsNotNull(field string)
-	Raw(sql string)
+	Op(field interface{}, op string, value interface{})
+	OpRaw(field interface{}, op string, rawValue interface{})
+	Eq(field, value interface{})
+	EqRaw(field, rawValue interface{})
+	Ne(field, value interface{})
+	NeRaw(field, rawValue interface{})
+	Lt(field, value interface{})
+	LtRaw(field, rawValue interface{})
+	Le(field, value interface{})
+	LeRaw(field, rawValue interface{})
+	Gt(field, value interface{})
+	GtRaw(field, rawValue interface{})
+	Ge(field, value interface{})
+	GeRaw(field, rawValue interface{})
+	NullSafeEq(field, value interface{})
+	NullSafeEqRaw(field, rawValue interface{})
+	Like(field, value interface{})
+	LikeRaw(field, rawValue interface{})
+	NotLike(field, value interface{})
+	NotLikeRaw(field, rawValue interface{})
+	Contains(field interface{}, value string)
+	StartsWith(field interface{}, value string)
+	EndsWith(field interface{}, value string)
+	ContainsFold(field interface{}, value, collation string)
+	StartsWithFold(field interface{}, value, collation string)
+	EndsWithFold(field interface{}, value, collation string)
+	Match(columns []string, search string, mode MatchMode)
+	JSONContains(field, value interface{}, path ...string)
+	JSONOverlaps(field, value interface{})
+	MemberOf(value, field interface{})
+	Regexp(field, value interface{})
+	NotRegexp(field, value interface{})
+	Between(field, from, to interface{})
+	NotBetween(field, from, to interface{})
+	In(field interface{}, value ...interface{})
+	InRaw(field interface{}, value ...interface{})
+	InSelect(field interface{}, f func(b SelectStatement))
+	InUnion(field interface{}, f func(b UnionStatement))
+	NotIn(field interface{}, value ...interface{})
+	NotInRaw(field interface{}, value ...interface{})
+	NotInSelect(field interface{}, f func(b SelectStatement))
+	NotInUnion(field interface{}, f func(b UnionStatement))
+	Exists(f func(b SelectStatement))
+	ExistsUnion(f func(b UnionStatement))
+	NotExists(f func(b SelectStatement))
+	NotExistsUnion(f func(b UnionStatement))
+	OpSelect(field interface{}, op string, f func(b SelectStatement))
+	OpUnion(field interface{}, op string, f func(b UnionStatement))
+	AnySelect(field interface{}, op string, f func(b SelectStatement))
+	AnyUnion(field interface{}, op string, f func(b UnionStatement))
+	AllSelect(field interface{}, op string, f func(b SelectStatement))
+	AllUnion(field interface{}, op string, f func(b UnionStatement))
+	TupleOp(columns []string, op string, value ...interface{})
+	TupleIn(columns []string, rows ...[]interface{})
+	IsNull(field interface{})
+	IsNotNull(field interface{})
+	IsTrue(field interface{})
+	IsFalse(field interface{})
+	Raw(sql string, args ...interface{})
 	And(f func(b Cond))
 	Or(f func(b Cond))
+	Not(f func(b Cond))
 	Mode() CondMode
 }
 
@@ -42,140 +83,374 @@ type cond struct {
 	nested bool
 }
 
-func (st *cond) Op(field, op string, value interface{}) {
+func (st *cond) Op(field interface{}, op string, value interface{}) {
 	var x group
 	x.sep = " "
 	x.push(field, op, Arg(value))
 	st.ops.push(&x)
 }
 
-func (st *cond) OpRaw(field, op string, rawValue interface{}) {
+func (st *cond) OpRaw(field interface{}, op string, rawValue interface{}) {
 	var x group
 	x.sep = " "
 	x.push(field, op, rawValue)
 	st.ops.push(&x)
 }
 
-func (st *cond) Eq(field string, value interface{}) {
+func (st *cond) Eq(field, value interface{}) {
 	st.Op(field, "=", value)
 }
 
-func (st *cond) EqRaw(field string, rawValue interface{}) {
+func (st *cond) EqRaw(field, rawValue interface{}) {
 	st.OpRaw(field, "=", rawValue)
 }
 
-func (st *cond) Ne(field string, value interface{}) {
+func (st *cond) Ne(field, value interface{}) {
 	st.Op(field, "!=", value)
 }
 
-func (st *cond) NeRaw(field string, rawValue interface{}) {
+func (st *cond) NeRaw(field, rawValue interface{}) {
 	st.OpRaw(field, "!=", rawValue)
 }
 
-func (st *cond) Lt(field string, value interface{}) {
+func (st *cond) Lt(field, value interface{}) {
 	st.Op(field, "<", value)
 }
 
-func (st *cond) LtRaw(field string, rawValue interface{}) {
+func (st *cond) LtRaw(field, rawValue interface{}) {
 	st.OpRaw(field, "<", rawValue)
 }
 
-func (st *cond) Le(field string, value interface{}) {
+func (st *cond) Le(field, value interface{}) {
 	st.Op(field, "<=", value)
 }
 
-func (st *cond) LeRaw(field string, rawValue interface{}) {
+func (st *cond) LeRaw(field, rawValue interface{}) {
 	st.OpRaw(field, "<=", rawValue)
 }
 
-func (st *cond) Gt(field string, value interface{}) {
+func (st *cond) Gt(field, value interface{}) {
 	st.Op(field, ">", value)
 }
 
-func (st *cond) GtRaw(field string, rawValue interface{}) {
+func (st *cond) GtRaw(field, rawValue interface{}) {
 	st.OpRaw(field, ">", rawValue)
 }
 
-func (st *cond) Ge(field string, value interface{}) {
+func (st *cond) Ge(field, value interface{}) {
 	st.Op(field, ">=", value)
 }
 
-func (st *cond) GeRaw(field string, rawValue interface{}) {
+func (st *cond) GeRaw(field, rawValue interface{}) {
 	st.OpRaw(field, ">=", rawValue)
 }
 
-func (st *cond) Like(field string, value interface{}) {
+// NullSafeEq compares field with value using null-safe equal operator (<=>)
+func (st *cond) NullSafeEq(field, value interface{}) {
+	st.Op(field, "<=>", value)
+}
+
+func (st *cond) NullSafeEqRaw(field, rawValue interface{}) {
+	st.OpRaw(field, "<=>", rawValue)
+}
+
+func (st *cond) Like(field, value interface{}) {
 	st.Op(field, "like", value)
 }
 
-func (st *cond) LikeRaw(field string, rawValue interface{}) {
+func (st *cond) LikeRaw(field, rawValue interface{}) {
 	st.OpRaw(field, "like", rawValue)
 }
 
-func (st *cond) In(field string, value ...interface{}) {
+func (st *cond) NotLike(field, value interface{}) {
+	st.Op(field, "not like", value)
+}
+
+func (st *cond) NotLikeRaw(field, rawValue interface{}) {
+	st.OpRaw(field, "not like", rawValue)
+}
+
+// Contains checks field contains value, value is escaped
+func (st *cond) Contains(field interface{}, value string) {
+	st.like(field, "", "%"+EscapeLike(value)+"%")
+}
+
+// StartsWith checks field starts with value, value is escaped
+func (st *cond) StartsWith(field interface{}, value string) {
+	st.like(field, "", EscapeLike(value)+"%")
+}
+
+// EndsWith checks field ends with value, value is escaped
+func (st *cond) EndsWith(field interface{}, value string) {
+	st.like(field, "", "%"+EscapeLike(value))
+}
+
+// ContainsFold likes Contains but compares using collation,
+// ex. utf8mb4_0900_ai_ci for case-insensitive
+func (st *cond) ContainsFold(field interface{}, value, collation string) {
+	st.like(field, collation, "%"+EscapeLike(value)+"%")
+}
+
+// StartsWithFold likes StartsWith but compares using collation
+func (st *cond) StartsWithFold(field interface{}, value, collation string) {
+	st.like(field, collation, EscapeLike(value)+"%")
+}
+
+// EndsWithFold likes EndsWith but compares using collation
+func (st *cond) EndsWithFold(field interface{}, value, collation string) {
+	st.like(field, collation, "%"+EscapeLike(value))
+}
+
+func (st *cond) like(field interface{}, collation string, pattern string) {
+	var x group
+	x.sep = " "
+	x.push(field)
+	if collation != "" {
+		x.push("collate", collation)
+	}
+	x.push("like", Arg(pattern), "escape", "'"+likeEscape+"'")
+	st.ops.push(&x)
+}
+
+// Match adds full-text search condition
+func (st *cond) Match(columns []string, search string, mode MatchMode) {
+	st.ops.push(Match(columns, search, mode))
+}
+
+// JSONContains checks json document field contains value at optional path
+func (st *cond) JSONContains(field, value interface{}, path ...string) {
+	st.ops.push(JSONContains(field, value, path...))
+}
+
+// JSONOverlaps checks json document field shares any element with value
+func (st *cond) JSONOverlaps(field, value interface{}) {
+	st.ops.push(JSONOverlaps(field, value))
+}
+
+// MemberOf checks value is an element of json array field
+func (st *cond) MemberOf(value, field interface{}) {
+	st.ops.push(MemberOf(value, field))
+}
+
+func (st *cond) Regexp(field, value interface{}) {
+	st.Op(field, "regexp", value)
+}
+
+func (st *cond) NotRegexp(field, value interface{}) {
+	st.Op(field, "not regexp", value)
+}
+
+func (st *cond) Between(field, from, to interface{}) {
+	st.ops.push(withGroup(" ", field, "between", Arg(from), "and", Arg(to)))
+}
+
+func (st *cond) NotBetween(field, from, to interface{}) {
+	st.ops.push(withGroup(" ", field, "not between", Arg(from), "and", Arg(to)))
+}
+
+// In checks field in values, single slice value will expand to values.
+// Empty values renders always false condition
+func (st *cond) In(field interface{}, value ...interface{}) {
+	st.in(field, "in", "false", expandValues(value), true)
+}
+
+func (st *cond) InRaw(field interface{}, value ...interface{}) {
+	st.in(field, "in", "false", value, false)
+}
+
+func (st *cond) InSelect(field interface{}, f func(b SelectStatement)) {
+	st.subquery(field, "in", subSelect(f))
+}
+
+func (st *cond) InUnion(field interface{}, f func(b UnionStatement)) {
+	st.subquery(field, "in", subUnion(f))
+}
+
+// NotIn checks field not in values, single slice value will expand to values.
+// Empty values renders always true condition
+func (st *cond) NotIn(field interface{}, value ...interface{}) {
+	st.in(field, "not in", "true", expandValues(value), true)
+}
+
+func (st *cond) NotInRaw(field interface{}, value ...interface{}) {
+	st.in(field, "not in", "true", value, false)
+}
+
+// in pushes field op (values), or empty when values is empty
+func (st *cond) in(field interface{}, op, empty string, value []interface{}, isArg bool) {
+	if len(value) == 0 {
+		st.ops.push(empty)
+		return
+	}
+
 	var p group
 	for _, v := range value {
-		p.push(Arg(v))
+		if isArg {
+			v = Arg(v)
+		}
+		p.push(v)
 	}
 
 	var x group
 	x.sep = " "
-	x.push(field, "in", paren(&p))
+	x.push(field, op, paren(&p))
 	st.ops.push(&x)
 }
 
-func (st *cond) InRaw(field string, value ...interface{}) {
-	var p group
-	p.push(value...)
+// expandValues expands single slice value into values,
+// []byte and driver.Valuer are not expand
+func expandValues(value []interface{}) []interface{} {
+	if len(value) != 1 {
+		return value
+	}
+	if _, ok := value[0].(driver.Valuer); ok {
+		return value
+	}
+	rv := reflect.ValueOf(value[0])
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return value
+	}
+	if xs, ok := value[0].([]interface{}); ok {
+		return xs
+	}
+	xs := make([]interface{}, rv.Len())
+	for i := range xs {
+		xs[i] = rv.Index(i).Interface()
+	}
+	return xs
+}
+
+func (st *cond) NotInSelect(field interface{}, f func(b SelectStatement)) {
+	st.subquery(field, "not in", subSelect(f))
+}
+
+func (st *cond) NotInUnion(field interface{}, f func(b UnionStatement)) {
+	st.subquery(field, "not in", subUnion(f))
+}
+
+func (st *cond) Exists(f func(b SelectStatement)) {
+	st.subquery(nil, "exists", subSelect(f))
+}
+
+func (st *cond) ExistsUnion(f func(b UnionStatement)) {
+	st.subquery(nil, "exists", subUnion(f))
+}
+
+func (st *cond) NotExists(f func(b SelectStatement)) {
+	st.subquery(nil, "not exists", subSelect(f))
+}
+
+func (st *cond) NotExistsUnion(f func(b UnionStatement)) {
+	st.subquery(nil, "not exists", subUnion(f))
+}
 
+// OpSelect compares field with scalar subquery, ex. field > (select ...)
+func (st *cond) OpSelect(field interface{}, op string, f func(b SelectStatement)) {
+	st.subquery(field, op, subSelect(f))
+}
+
+func (st *cond) OpUnion(field interface{}, op string, f func(b UnionStatement)) {
+	st.subquery(field, op, subUnion(f))
+}
+
+// AnySelect compares field with any row from subquery, ex. field > any (select ...)
+func (st *cond) AnySelect(field interface{}, op string, f func(b SelectStatement)) {
+	st.subquery(field, op+" any", subSelect(f))
+}
+
+func (st *cond) AnyUnion(field interface{}, op string, f func(b UnionStatement)) {
+	st.subquery(field, op+" any", subUnion(f))
+}
+
+// AllSelect compares field with all rows from subquery, ex. field > all (select ...)
+func (st *cond) AllSelect(field interface{}, op string, f func(b SelectStatement)) {
+	st.subquery(field, op+" all", subSelect(f))
+}
+
+func (st *cond) AllUnion(field interface{}, op string, f func(b UnionStatement)) {
+	st.subquery(field, op+" all", subUnion(f))
+}
+
+// subquery pushes field op (subquery), nil field will be omitted
+func (st *cond) subquery(field interface{}, op string, q *buffer) {
 	var x group
 	x.sep = " "
-	x.push(field, "in", paren(&p))
+	if field != nil {
+		x.push(field)
+	}
+	x.push(op, paren(q))
 	st.ops.push(&x)
 }
 
-func (st *cond) InSelect(field string, f func(b SelectStatement)) {
+func subSelect(f func(b SelectStatement)) *buffer {
 	var x selectStmt
 	f(&x)
+	return x.make()
+}
 
-	var p group
-	p.sep = " "
-	p.push(field, "in", paren(x.make()))
-	st.ops.push(&p)
+func subUnion(f func(b UnionStatement)) *buffer {
+	var x unionStmt
+	f(&x)
+	return x.make()
 }
 
-func (st *cond) NotIn(field string, value ...interface{}) {
+// TupleOp compares row constructor, ex. (a, b) > (?, ?)
+func (st *cond) TupleOp(columns []string, op string, value ...interface{}) {
+	st.ops.push(withGroup(" ", parenString(columns...), op, tuple(columns, value)))
+}
+
+// TupleIn checks row constructor in rows, ex. (a, b) in ((?, ?), (?, ?)).
+// Empty rows renders always false condition
+func (st *cond) TupleIn(columns []string, rows ...[]interface{}) {
+	if len(rows) == 0 {
+		st.ops.push("false")
+		return
+	}
+
 	var p group
-	for _, v := range value {
-		p.push(Arg(v))
+	for _, row := range rows {
+		p.push(tuple(columns, row))
 	}
+	st.ops.push(withGroup(" ", parenString(columns...), "in", paren(&p)))
+}
 
-	var x group
-	x.sep = " "
-	x.push(field, "not in", paren(&p))
-	st.ops.push(&x)
+// tuple returns row of arguments, or error when row arity mismatch columns
+func tuple(columns []string, row []interface{}) interface{} {
+	if len(columns) == 0 {
+		return errorf("mystmt: tuple requires columns")
+	}
+	if len(row) != len(columns) {
+		return errorf("mystmt: tuple has %d columns but got %d values", len(columns), len(row))
+	}
+	var p parenGroup
+	for _, v := range row {
+		p.push(Arg(v))
+	}
+	return &p
 }
 
-func (st *cond) NotInRaw(field string, value ...interface{}) {
-	var p group
-	p.push(value...)
+func (st *cond) IsNull(field interface{}) {
+	st.ops.push(withGroup(" ", field, "is null"))
+}
 
-	var x group
-	x.sep = " "
-	x.push(field, "not in", paren(&p))
-	st.ops.push(&x)
+func (st *cond) IsNotNull(field interface{}) {
+	st.ops.push(withGroup(" ", field, "is not null"))
 }
 
-func (st *cond) IsNull(field string) {
-	st.ops.push(field + " is null")
+func (st *cond) IsTrue(field interface{}) {
+	st.ops.push(withGroup(" ", field, "is true"))
 }
 
-func (st *cond) IsNotNull(field string) {
-	st.ops.push(field + " is not null")
+func (st *cond) IsFalse(field interface{}) {
+	st.ops.push(withGroup(" ", field, "is false"))
 }
 
-func (st *cond) Raw(sql string) {
-	st.ops.push(sql)
+func (st *cond) Raw(sql string, args ...interface{}) {
+	if len(args) == 0 {
+		st.ops.push(sql)
+		return
+	}
+	st.ops.push(RawArgs(sql, args...))
 }
 
 func (st *cond) And(f func(b Cond)) {
@@ -202,6 +477,18 @@ func (st *cond) Or(f func(b Cond)) {
 	}
 }
 
+// Not negates all conditions inside f, ex. not (a = ? or b = ?)
+func (st *cond) Not(f func(b Cond)) {
+	var x cond
+	x.ops.sep = " and "
+	x.nested = true
+	f(&x)
+
+	if !x.empty() {
+		st.ops.push(withGroup(" ", "not", &x))
+	}
+}
+
 func (st *cond) Mode() CondMode {
 	return &condMode{st}
 }
@@ -210,38 +497,39 @@ func (st *cond) empty() bool {
 	return st.ops.empty() && st.chain.empty()
 }
 
+// build renders condition without modify st, so st can build many times
 func (st *cond) build() []interface{} {
 	if st.empty() {
 		return nil
 	}
 
 	if st.ops.empty() {
-		st.chain.popFront()
-
-		if len(st.chain.q) > 1 {
+		// skip first chain operator
+		chain := st.chain.q[1:]
+		if len(chain) > 1 {
 			var b parenGroup
 			b.sep = " "
-			b.push(st.chain.q...)
+			b.push(chain...)
 			return []interface{}{&b}
 		}
-
-		return st.chain.q
+		return chain
 	}
 
-	if st.ops.sep == "" {
-		st.ops.sep = " and "
+	ops := st.ops
+	if ops.sep == "" {
+		ops.sep = " and "
 	}
 
 	if st.nested && !st.chain.empty() {
 		var b parenGroup
 		b.sep = " "
-		b.push(&st.ops)
+		b.push(&ops)
 		b.push(st.chain.q...)
 		return []interface{}{&b}
 	}
 
 	var b buffer
-	b.push(&st.ops)
+	b.push(&ops)
 	b.push(st.chain.q...)
 	return b.q
 }
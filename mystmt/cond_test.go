@@ -0,0 +1,196 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestCond(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"operators",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Between("age", 18, 60)
+					b.NotBetween("created_at", "2020-01-01", "2020-12-31")
+					b.NotLike("name", "%test%")
+					b.Regexp("email", "^a")
+					b.NotRegexp("email", "^b")
+					b.NullSafeEq("deleted_at", nil)
+					b.IsTrue("active")
+					b.IsFalse("banned")
+				})
+			}),
+			`
+				select * from users
+				where (age between ? and ?
+					and created_at not between ? and ?
+					and name not like ?
+					and email regexp ?
+					and email not regexp ?
+					and deleted_at <=> ?
+					and active is true
+					and banned is false)
+			`,
+			[]interface{}{18, 60, "2020-01-01", "2020-12-31", "%test%", "^a", "^b", nil},
+		},
+		{
+			"not",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("tenant_id", 1)
+					b.Not(func(b mystmt.Cond) {
+						b.Mode().Or()
+						b.Eq("role", "admin")
+						b.Eq("role", "owner")
+					})
+					b.Not(func(b mystmt.Cond) {
+						b.Eq("a", 1)
+						b.Or(func(b mystmt.Cond) {
+							b.Eq("b", 2)
+						})
+					})
+					b.Not(func(b mystmt.Cond) {})
+				})
+			}),
+			`
+				select * from users
+				where (tenant_id = ?
+					and not (role = ? or role = ?)
+					and not ((a = ?) or (b = ?)))
+			`,
+			[]interface{}{1, "admin", "owner", 1, 2},
+		},
+		{
+			"not only chain",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Not(func(b mystmt.Cond) {
+						b.And(func(b mystmt.Cond) {
+							b.Eq("a", 1)
+						})
+						b.Or(func(b mystmt.Cond) {
+							b.Eq("b", 2)
+						})
+					})
+				})
+			}),
+			`
+				select * from users
+				where (not ((a = ?) or (b = ?)))
+			`,
+			[]interface{}{1, 2},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+		})
+	}
+
+	t.Run("build many times", func(t *testing.T) {
+		x := mystmt.Case(func(b mystmt.CaseExpr) {
+			b.When(func(b mystmt.Cond) {
+				b.And(func(b mystmt.Cond) {
+					b.Eq("a", 1)
+				})
+				b.Or(func(b mystmt.Cond) {
+					b.Eq("b", 2)
+				})
+			}).Then(1)
+			b.Else(0)
+		})
+
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(x)
+			b.From("t")
+			b.OrderBy(x)
+		}).SQL()
+		assert.Equal(t,
+			"select case when ((a = ?) or (b = ?)) then 1 else 0 end from t order by case when ((a = ?) or (b = ?)) then 1 else 0 end",
+			q,
+		)
+		assert.EqualValues(t, []interface{}{1, 2, 1, 2}, args)
+	})
+}
+
+func TestCondIn(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"empty",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("tenant_id", 1)
+					b.In("id")
+					b.NotIn("id", []int64{})
+					b.InRaw("status")
+					b.TupleIn([]string{"a", "b"})
+				})
+			}),
+			"select * from users where (tenant_id = ? and false and true and false and false)",
+			[]interface{}{1},
+		},
+		{
+			"expand slice",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.In("id", []int64{1, 2, 3})
+					b.NotIn("name", []string{"a", "b"})
+					b.In("tag", []interface{}{"x"})
+				})
+			}),
+			"select * from users where (id in (?, ?, ?) and name not in (?, ?) and tag in (?))",
+			[]interface{}{int64(1), int64(2), int64(3), "a", "b", "x"},
+		},
+		{
+			"not expand bytes",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("files")
+				b.Where(func(b mystmt.Cond) {
+					b.In("hash", []byte("abc"))
+				})
+			}),
+			"select * from files where (hash in (?))",
+			[]interface{}{[]byte("abc")},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, tC.query, q)
+			assert.EqualValues(t, tC.args, args)
+		})
+	}
+}
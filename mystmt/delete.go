@@ -2,36 +2,145 @@ package mystmt
 
 // Delete builds delete statement
 func Delete(f func(b DeleteStatement)) *Result {
-	var st deleteStmt
-	f(&st)
-	return newResult(build(st.make()))
+	return NewDelete(f).Build()
 }
 
+// DeleteBuilder is the reusable delete statement builder, see Builder
+type DeleteBuilder = Builder[DeleteStatement]
+
+// NewDelete creates new delete statement builder
+func NewDelete(f ...func(b DeleteStatement)) *DeleteBuilder {
+	return newBuilder(func() (DeleteStatement, statement) {
+		var st deleteStmt
+		return &st, &st
+	}, f)
+}
+
+// DeleteStatement is the delete statement builder.
+//
+// Multiple-table delete requires Target with Join, or Using where From is the target.
+// ORDER BY and LIMIT are not allowed on multiple-table delete (Target, Using or Join).
 type DeleteStatement interface {
+	OptimizerHint(hint ...string)
+	Target(table ...string)
 	From(table string)
+	Using(table ...string)
+	Join(table string) Join
+	InnerJoin(table string) Join
+	LeftJoin(table string) Join
+	RightJoin(table string) Join
 	Where(f func(b Cond))
+	OrderBy(col interface{}) OrderBy
+	Limit(n int64)
 }
 
 type deleteStmt struct {
-	from  string
-	where cond
+	hints   optimizerHints
+	targets group
+	from    string
+	using   group
+	joins   buffer
+	where   cond
+	orderBy group
+	limit   *int64
+}
+
+func (st *deleteStmt) OptimizerHint(hint ...string) {
+	st.hints.push(hint...)
+}
+
+func (st *deleteStmt) Target(table ...string) {
+	st.targets.pushString(table...)
 }
 
 func (st *deleteStmt) From(table string) {
 	st.from = table
 }
 
+func (st *deleteStmt) Using(table ...string) {
+	st.using.pushString(table...)
+}
+
+func (st *deleteStmt) join(typ, table string) Join {
+	var b buffer
+	b.push(table)
+	x := join{
+		typ:   typ,
+		table: &b,
+	}
+	st.joins.push(&x)
+	return &x
+}
+
+func (st *deleteStmt) Join(table string) Join {
+	return st.join("join", table)
+}
+
+func (st *deleteStmt) InnerJoin(table string) Join {
+	return st.join("inner join", table)
+}
+
+func (st *deleteStmt) LeftJoin(table string) Join {
+	return st.join("left join", table)
+}
+
+func (st *deleteStmt) RightJoin(table string) Join {
+	return st.join("right join", table)
+}
+
 func (st *deleteStmt) Where(f func(b Cond)) {
 	f(&st.where)
 }
 
+func (st *deleteStmt) OrderBy(col interface{}) OrderBy {
+	p := orderBy{
+		col: col,
+	}
+	st.orderBy.push(&p)
+	return &p
+}
+
+func (st *deleteStmt) Limit(n int64) {
+	st.limit = &n
+}
+
 func (st *deleteStmt) make() *buffer {
+	multiTable := !st.targets.empty() || !st.using.empty() || !st.joins.empty()
+
 	var b buffer
-	b.push("delete from", st.from)
+	switch {
+	case !st.targets.empty() && !st.using.empty():
+		b.push(errorf("mystmt: delete can not use both target and using"))
+	case !st.joins.empty() && st.targets.empty() && st.using.empty():
+		b.push(errorf("mystmt: delete with join requires target"))
+	}
+	if multiTable && (!st.orderBy.empty() || st.limit != nil) {
+		b.push(errorf("mystmt: order by and limit are not allowed on multiple-table delete"))
+	}
+	b.push("delete")
+	if !st.hints.empty() {
+		b.push(&st.hints)
+	}
+	if !st.targets.empty() {
+		b.push(&st.targets)
+	}
+	b.push("from", st.from)
+	if !st.using.empty() {
+		b.push("using", &st.using)
+	}
+	if !st.joins.empty() {
+		b.push(&st.joins)
+	}
 	if !st.where.empty() {
 		b.push("where")
 		b.push(st.where.build()...)
 	}
+	if !st.orderBy.empty() {
+		b.push("order by", &st.orderBy)
+	}
+	if st.limit != nil {
+		b.push("limit", *st.limit)
+	}
 
 	return &b
 }
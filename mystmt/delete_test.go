@@ -32,3 +32,121 @@ func TestDelete(t *testing.T) {
 		args,
 	)
 }
+
+func TestDeleteOrderByLimit(t *testing.T) {
+	t.Parallel()
+
+	q, args := mystmt.Delete(func(b mystmt.DeleteStatement) {
+		b.From("events")
+		b.Where(func(b mystmt.Cond) {
+			b.Lt("created_at", "2020-01-01")
+		})
+		b.OrderBy("id")
+		b.Limit(1000)
+	}).SQL()
+
+	assert.Equal(t,
+		"delete from events where (created_at < ?) order by id limit 1000",
+		q,
+	)
+	assert.EqualValues(t,
+		[]interface{}{"2020-01-01"},
+		args,
+	)
+}
+
+func TestDeleteMultipleTable(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"target join",
+			mystmt.Delete(func(b mystmt.DeleteStatement) {
+				b.Target("t1", "t2")
+				b.From("t1")
+				b.Join("t2").On(func(b mystmt.Cond) {
+					b.EqRaw("t1.id", "t2.t1_id")
+				})
+				b.LeftJoin("t3").Using("id")
+				b.Where(func(b mystmt.Cond) {
+					b.IsNull("t3.id")
+					b.Eq("t1.status", 2)
+				})
+			}),
+			`
+				delete t1, t2
+				from t1
+				join t2 on (t1.id = t2.t1_id)
+				left join t3 using (id)
+				where (t3.id is null and t1.status = ?)
+			`,
+			[]interface{}{2},
+		},
+		{
+			"using",
+			mystmt.Delete(func(b mystmt.DeleteStatement) {
+				b.From("t1")
+				b.Using("t1")
+				b.InnerJoin("t2").On(func(b mystmt.Cond) {
+					b.EqRaw("t1.id", "t2.id")
+				})
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("t2.deleted", true)
+				})
+			}),
+			"delete from t1 using t1 inner join t2 on (t1.id = t2.id) where (t2.deleted = ?)",
+			[]interface{}{true},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("join without target", func(t *testing.T) {
+		r := mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.From("t1")
+			b.Join("t2").Using("id")
+			b.OrderBy("t1.id")
+			b.Limit(10)
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("order by limit", func(t *testing.T) {
+		r := mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.Target("t1")
+			b.From("t1")
+			b.Join("t2").Using("id")
+			b.OrderBy("t1.id")
+			b.Limit(10)
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.From("t1")
+			b.Using("t1")
+			b.Limit(10)
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("target with using", func(t *testing.T) {
+		r := mystmt.Delete(func(b mystmt.DeleteStatement) {
+			b.Target("t1")
+			b.From("t1")
+			b.Using("t1")
+		})
+		assert.Error(t, r.Err())
+	})
+}
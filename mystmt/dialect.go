@@ -0,0 +1,18 @@
+package mystmt
+
+// Dialect is the server dialect use to render dialect specific syntax
+type Dialect int
+
+// Supported dialects
+const (
+	MySQL80 Dialect = iota
+	MySQL57
+	MariaDB
+)
+
+// DefaultDialect is the dialect use when build statement
+var DefaultDialect = MySQL80
+
+// NoBackslashEscapes renders string literals for server with
+// sql_mode NO_BACKSLASH_ESCAPES, quote is escaped by doubling it
+var NoBackslashEscapes = false
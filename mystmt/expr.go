@@ -0,0 +1,179 @@
+package mystmt
+
+// Expr is the SQL expression,
+// expression can be use as column, value or field
+type Expr interface {
+	builder
+}
+
+type expr struct {
+	q []interface{}
+}
+
+func newExpr(q ...interface{}) Expr {
+	return &expr{q}
+}
+
+func (x *expr) build() []interface{} {
+	return x.q
+}
+
+// Col builds column reference expression
+func Col(name string) Expr {
+	return newExpr(name)
+}
+
+// Func builds function call expression, arguments are not marked as argument,
+// use Arg to mark value as argument
+func Func(name string, arg ...interface{}) Expr {
+	if len(arg) == 0 {
+		return newExpr(name + "()")
+	}
+
+	var p parenGroup
+	p.prefix = name
+	p.push(arg...)
+	return newExpr(&p)
+}
+
+// As builds alias expression
+func As(x interface{}, alias string) Expr {
+	return newExpr(x, alias)
+}
+
+// Cast builds cast expression
+func Cast(x interface{}, typ string) Expr {
+	var p parenGroup
+	p.prefix = "cast"
+	p.sep = " "
+	p.push(x, "as", typ)
+	return newExpr(&p)
+}
+
+func operator(op string, x ...interface{}) Expr {
+	return newExpr(withParen(" "+op+" ", x...))
+}
+
+// Add builds addition expression
+func Add(x ...interface{}) Expr {
+	return operator("+", x...)
+}
+
+// Sub builds subtraction expression
+func Sub(x ...interface{}) Expr {
+	return operator("-", x...)
+}
+
+// Mul builds multiplication expression
+func Mul(x ...interface{}) Expr {
+	return operator("*", x...)
+}
+
+// Div builds division expression
+func Div(x ...interface{}) Expr {
+	return operator("/", x...)
+}
+
+// Mod builds modulo expression
+func Mod(x ...interface{}) Expr {
+	return operator("%", x...)
+}
+
+// Case builds case expression
+func Case(f func(b CaseExpr)) Expr {
+	var x caseExpr
+	f(&x)
+	return &x
+}
+
+// CaseExpr is the case expression builder,
+// values are not marked as argument, use Arg to mark value as argument
+type CaseExpr interface {
+	Value(value interface{})
+	When(f func(b Cond)) Then
+	WhenValue(value interface{}) Then
+	Else(value interface{})
+}
+
+// Then is the case result builder, every when requires Then
+type Then interface {
+	Then(value interface{})
+}
+
+type caseExpr struct {
+	value    interface{}
+	whens    buffer
+	elseThen interface{}
+	hasElse  bool
+}
+
+func (st *caseExpr) Value(value interface{}) {
+	st.value = value
+}
+
+func (st *caseExpr) When(f func(b Cond)) Then {
+	var x cond
+	f(&x)
+
+	w := when{
+		cond: &x,
+	}
+	st.whens.push(&w)
+	return &w
+}
+
+func (st *caseExpr) WhenValue(value interface{}) Then {
+	w := when{
+		cond: value,
+	}
+	st.whens.push(&w)
+	return &w
+}
+
+func (st *caseExpr) Else(value interface{}) {
+	st.elseThen = value
+	st.hasElse = true
+}
+
+func (st *caseExpr) build() []interface{} {
+	var b buffer
+	b.push("case")
+	if st.value != nil {
+		b.push(st.value)
+	}
+	if st.whens.empty() {
+		b.push(errorf("mystmt: case requires when"))
+	}
+	b.push(&st.whens)
+	if st.hasElse {
+		b.push("else", nullValue(st.elseThen))
+	}
+	b.push("end")
+	return b.q
+}
+
+type when struct {
+	cond    interface{}
+	then    interface{}
+	hasThen bool
+}
+
+func (st *when) Then(value interface{}) {
+	st.then = value
+	st.hasThen = true
+}
+
+func (st *when) build() []interface{} {
+	if !st.hasThen {
+		return []interface{}{errorf("mystmt: case when requires then")}
+	}
+	return []interface{}{"when", st.cond, "then", nullValue(st.then)}
+}
+
+// nullValue returns null for nil value
+func nullValue(v interface{}) interface{} {
+	if v == nil {
+		return "null"
+	}
+	return v
+}
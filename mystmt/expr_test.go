@@ -0,0 +1,171 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestExpr(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"function in condition",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("docs")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq(mystmt.Func("json_extract", "data", mystmt.Arg("$.x")), "y")
+				})
+			}),
+			"select id from docs where (json_extract(data, ?) = ?)",
+			[]interface{}{"$.x", "y"},
+		},
+		{
+			"arithmetic column and condition",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(
+					mystmt.As(mystmt.Add(mystmt.Func("coalesce", "a", "b"), mystmt.Arg(1)), "total"),
+					mystmt.Mul(mystmt.Sub("price", "discount"), mystmt.Col("qty")),
+				)
+				b.From("items")
+				b.Where(func(b mystmt.Cond) {
+					b.Gt(mystmt.Div("price", 2), mystmt.Arg(10))
+					b.Eq(mystmt.Mod("id", 2), 0)
+				})
+			}),
+			"select (coalesce(a, b) + ?) total, ((price - discount) * qty) from items where ((price / 2) > ? and (id % 2) = ?)",
+			[]interface{}{1, 10, 0},
+		},
+		{
+			"expression as value",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Lt("updated_at", mystmt.Func("now"))
+					b.In("status", mystmt.Func("upper", mystmt.Arg("a")), "b")
+					b.IsNull(mystmt.Func("nullif", "name", mystmt.Arg("")))
+				})
+			}),
+			"select id from users where (updated_at < now() and status in (upper(?), ?) and nullif(name, ?) is null)",
+			[]interface{}{"a", "b", ""},
+		},
+		{
+			"case when",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.As(mystmt.Case(func(b mystmt.CaseExpr) {
+					b.When(func(b mystmt.Cond) {
+						b.Gt("score", 80)
+					}).Then(mystmt.Arg("A"))
+					b.When(func(b mystmt.Cond) {
+						b.Gt("score", 50)
+					}).Then(mystmt.Arg("B"))
+					b.Else(mystmt.Arg("C"))
+				}), "grade"))
+				b.From("results")
+			}),
+			"select case when (score > ?) then ? when (score > ?) then ? else ? end grade from results",
+			[]interface{}{80, "A", 50, "B", "C"},
+		},
+		{
+			"simple case and cast",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(
+					mystmt.Case(func(b mystmt.CaseExpr) {
+						b.Value("status")
+						b.WhenValue(mystmt.Arg(1)).Then("'active'")
+						b.WhenValue(mystmt.Arg(2)).Then("'inactive'")
+					}),
+					mystmt.Cast(mystmt.Arg("12"), "unsigned"),
+				)
+				b.From("users")
+				b.GroupByExpr(mystmt.Func("date", "created_at"))
+				b.OrderBy(mystmt.Cast("code", "char(3)")).Desc()
+			}),
+			`
+				select case status when ? then 'active' when ? then 'inactive' end, cast(? as unsigned)
+				from users
+				group by date(created_at)
+				order by cast(code as char(3)) desc
+			`,
+			[]interface{}{1, 2, "12"},
+		},
+		{
+			"expression in update",
+			mystmt.Update(func(b mystmt.UpdateStatement) {
+				b.Table("accounts")
+				b.Set("balance").To(mystmt.Add("balance", mystmt.Arg(100)))
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("id", 1)
+				})
+			}),
+			"update accounts set balance = (balance + ?) where (id = ?)",
+			[]interface{}{100, 1},
+		},
+		{
+			"expression in insert",
+			mystmt.Insert(func(b mystmt.InsertStatement) {
+				b.Into("users")
+				b.Columns("name", "created_at")
+				b.Value(mystmt.Func("upper", mystmt.Arg("test")), mystmt.Func("now"))
+			}),
+			"insert into users (name, created_at) values (upper(?), now())",
+			[]interface{}{"test"},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("when without then", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(mystmt.Case(func(b mystmt.CaseExpr) {
+				b.When(func(b mystmt.Cond) {
+					b.Gt("age", 18)
+				})
+				b.Else("'minor'")
+			}))
+			b.From("users")
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("case without when", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(mystmt.Case(func(b mystmt.CaseExpr) {
+				b.Else("'minor'")
+			}))
+			b.From("users")
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("then else null", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(mystmt.Case(func(b mystmt.CaseExpr) {
+				b.Value("status")
+				b.WhenValue(1).Then(nil)
+				b.Else(nil)
+			}))
+			b.From("users")
+		})
+		q, _ := r.SQL()
+		assert.NoError(t, r.Err())
+		assert.Equal(t, "select case status when 1 then null else null end from users", q)
+	})
+}
@@ -0,0 +1,52 @@
+package mystmt
+
+import (
+	"strings"
+)
+
+// MatchMode is the full-text search modifier
+type MatchMode int
+
+// Full-text search modes
+const (
+	MatchNaturalLanguage MatchMode = iota
+	MatchBoolean
+	MatchQueryExpansion
+)
+
+func (m MatchMode) String() string {
+	switch m {
+	case MatchBoolean:
+		return "in boolean mode"
+	case MatchQueryExpansion:
+		return "with query expansion"
+	default:
+		return "in natural language mode"
+	}
+}
+
+// Match builds full-text search expression,
+// the result is relevance score when use as column
+//
+//	match (title, body) against (? in boolean mode)
+func Match(columns []string, search string, mode MatchMode) Expr {
+	return newExpr(
+		"match", parenString(columns...),
+		"against", withParen(" ", Arg(search), mode.String()),
+	)
+}
+
+// matchBooleanOperators is the boolean mode operators
+const matchBooleanOperators = `+-<>()~*"@`
+
+// SanitizeMatchBoolean removes boolean mode operators from s,
+// use to search user input in MatchBoolean mode
+func SanitizeMatchBoolean(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(matchBooleanOperators, r) {
+			return ' '
+		}
+		return r
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
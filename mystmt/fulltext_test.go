@@ -0,0 +1,53 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("relevance", func(t *testing.T) {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("id", mystmt.As(mystmt.Match([]string{"title", "body"}, "database", mystmt.MatchNaturalLanguage), "score"))
+			b.From("products")
+			b.Where(func(b mystmt.Cond) {
+				b.Match([]string{"title", "body"}, "+mysql -oracle", mystmt.MatchBoolean)
+			})
+			b.OrderBy("score").Desc()
+		}).SQL()
+
+		assert.Equal(t,
+			stripSpace(`
+				select id, match (title, body) against (? in natural language mode) score
+				from products
+				where (match (title, body) against (? in boolean mode))
+				order by score desc
+			`),
+			q,
+		)
+		assert.EqualValues(t, []interface{}{"database", "+mysql -oracle"}, args)
+	})
+
+	t.Run("query expansion", func(t *testing.T) {
+		q, _ := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("products")
+			b.Where(func(b mystmt.Cond) {
+				b.Match([]string{"title"}, "database", mystmt.MatchQueryExpansion)
+			})
+		}).SQL()
+
+		assert.Equal(t, "select * from products where (match (title) against (? with query expansion))", q)
+	})
+
+	t.Run("sanitize", func(t *testing.T) {
+		assert.Equal(t, "my sql oracle db", mystmt.SanitizeMatchBoolean(`+my-sql ~"oracle" (db*)`))
+		assert.Equal(t, "a b 3", mystmt.SanitizeMatchBoolean(`>a <b @3`))
+		assert.Equal(t, "", mystmt.SanitizeMatchBoolean(`+-*`))
+	})
+}
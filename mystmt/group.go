@@ -56,3 +56,8 @@ func withParen(sep string, q ...interface{}) interface{} {
 	p.push(q...)
 	return &p
 }
+
+// concatGroup joins q without separator
+type concatGroup struct {
+	group
+}
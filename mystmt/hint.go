@@ -0,0 +1,139 @@
+package mystmt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SelectModifier is the MySQL select modifier
+type SelectModifier string
+
+// Select modifiers
+const (
+	HighPriority     SelectModifier = "high_priority"
+	StraightJoin     SelectModifier = "straight_join"
+	SQLSmallResult   SelectModifier = "sql_small_result"
+	SQLBigResult     SelectModifier = "sql_big_result"
+	SQLBufferResult  SelectModifier = "sql_buffer_result"
+	SQLNoCache       SelectModifier = "sql_no_cache"
+	SQLCalcFoundRows SelectModifier = "sql_calc_found_rows"
+)
+
+// MaxExecutionTime builds max_execution_time optimizer hint
+func MaxExecutionTime(ms int64) string {
+	return "max_execution_time(" + strconv.FormatInt(ms, 10) + ")"
+}
+
+type optimizerHints struct {
+	hints []string
+}
+
+func (st *optimizerHints) push(hint ...string) {
+	st.hints = append(st.hints, hint...)
+}
+
+func (st *optimizerHints) empty() bool {
+	return len(st.hints) == 0
+}
+
+func (st *optimizerHints) build() []interface{} {
+	if st.empty() {
+		return nil
+	}
+	for _, x := range st.hints {
+		if strings.Contains(x, "*/") {
+			return []interface{}{errorf("mystmt: optimizer hint can not contain */")}
+		}
+	}
+	return []interface{}{"/*+ " + strings.Join(st.hints, " ") + " */"}
+}
+
+// IndexHint is the index hint builder
+type IndexHint interface {
+	ForJoin()
+	ForOrderBy()
+	ForGroupBy()
+}
+
+// Table is the table reference builder
+type Table interface {
+	UseIndex(index ...string) IndexHint
+	ForceIndex(index ...string) IndexHint
+	IgnoreIndex(index ...string) IndexHint
+}
+
+type indexHints struct {
+	hints group
+}
+
+func (st *indexHints) hint(typ string, index ...string) IndexHint {
+	x := indexHint{
+		typ: typ,
+	}
+	x.index.pushString(index...)
+	st.hints.sep = " "
+	st.hints.push(&x)
+	return &x
+}
+
+func (st *indexHints) UseIndex(index ...string) IndexHint {
+	return st.hint("use index", index...)
+}
+
+func (st *indexHints) ForceIndex(index ...string) IndexHint {
+	return st.hint("force index", index...)
+}
+
+func (st *indexHints) IgnoreIndex(index ...string) IndexHint {
+	return st.hint("ignore index", index...)
+}
+
+type indexHint struct {
+	typ   string // use index, force index, ignore index
+	scope string
+	index parenGroup
+}
+
+func (st *indexHint) ForJoin() {
+	st.scope = "join"
+}
+
+func (st *indexHint) ForOrderBy() {
+	st.scope = "order by"
+}
+
+func (st *indexHint) ForGroupBy() {
+	st.scope = "group by"
+}
+
+func (st *indexHint) build() []interface{} {
+	var b buffer
+	b.push(st.typ)
+	if st.scope != "" {
+		b.push("for", st.scope)
+	}
+	if st.index.empty() {
+		// only use index accepts empty index list
+		if st.typ != "use index" {
+			b.push(errorf("mystmt: %s requires index", st.typ))
+		}
+		b.push("()")
+	} else {
+		b.push(&st.index)
+	}
+	return b.q
+}
+
+type tableRef struct {
+	indexHints
+	name interface{}
+}
+
+func (st *tableRef) build() []interface{} {
+	var b buffer
+	b.push(st.name)
+	if !st.hints.empty() {
+		b.push(&st.hints)
+	}
+	return b.q
+}
@@ -0,0 +1,169 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestHint(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"select modifiers",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Distinct()
+				b.Modifier(mystmt.HighPriority, mystmt.StraightJoin, mystmt.SQLNoCache, mystmt.SQLCalcFoundRows)
+				b.Columns("id")
+				b.From("users")
+			}),
+			"select distinct high_priority straight_join sql_no_cache sql_calc_found_rows id from users",
+			nil,
+		},
+		{
+			"select optimizer hint",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.OptimizerHint(mystmt.MaxExecutionTime(1000), "bka(t1)")
+				b.Distinct()
+				b.Columns("id")
+				b.From("users")
+			}),
+			"select /*+ max_execution_time(1000) bka(t1) */ distinct id from users",
+			nil,
+		},
+		{
+			"from index hint",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("users").ForceIndex("users_email_idx")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("email", "test@localhost")
+				})
+			}),
+			"select id from users force index (users_email_idx) where (email = ?)",
+			[]interface{}{"test@localhost"},
+		},
+		{
+			"from multiple tables index hint",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("t1", "t2").IgnoreIndex("i1").ForOrderBy()
+				b.From("t3").UseIndex()
+			}),
+			"select * from t1, t2 ignore index for order by (i1), t3 use index ()",
+			nil,
+		},
+		{
+			"join index hint",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("orders o")
+				b.LeftJoin("users u").UseIndex("primary", "users_id_idx").ForJoin()
+				b.Join("items i").IgnoreIndex("items_order_id_idx")
+				b.StraightJoin("payments p").On(func(b mystmt.Cond) {
+					b.EqRaw("p.order_id", "o.id")
+				})
+			}),
+			`
+				select *
+				from orders o
+				left join users u use index for join (primary, users_id_idx)
+				join items i ignore index (items_order_id_idx)
+				straight_join payments p on (p.order_id = o.id)
+			`,
+			nil,
+		},
+		{
+			"join index hint with on",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("orders o")
+				j := b.Join("users u")
+				j.ForceIndex("primary").ForGroupBy()
+				j.Using("user_id")
+			}),
+			"select * from orders o join users u force index for group by (primary) using (user_id)",
+			nil,
+		},
+		{
+			"update optimizer hint",
+			mystmt.Update(func(b mystmt.UpdateStatement) {
+				b.OptimizerHint(mystmt.MaxExecutionTime(500))
+				b.Table("users")
+				b.Set("name").To("test")
+			}),
+			"update /*+ max_execution_time(500) */ users set name = ?",
+			[]interface{}{"test"},
+		},
+		{
+			"delete optimizer hint",
+			mystmt.Delete(func(b mystmt.DeleteStatement) {
+				b.OptimizerHint("no_index_merge(users)")
+				b.From("users")
+			}),
+			"delete /*+ no_index_merge(users) */ from users",
+			nil,
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("invalid optimizer hint", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.OptimizerHint("bka(t1) */ sleep(10) /*")
+			b.Columns("*")
+			b.From("t1")
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("empty index", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("t1").ForceIndex()
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("t1").IgnoreIndex()
+		})
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("derived table", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("t1")
+			b.JoinSelect(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("t2")
+			}, "t2").UseIndex("k")
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("t1")
+			b.JoinJSONTable("t1.doc", "$[*]", func(b mystmt.JSONTableColumns) {
+				b.Column("x", "int", "$")
+			}, "j").ForceIndex("k")
+		})
+		assert.Error(t, r.Err())
+	})
+}
@@ -0,0 +1,82 @@
+package mystmt
+
+// CreateIndex builds create index statement
+func CreateIndex(f func(b CreateIndexStatement)) *Result {
+	var st createIndexStmt
+	f(&st)
+	return newResult(build(st.make()))
+}
+
+// CreateIndexStatement is the create index statement builder
+type CreateIndexStatement interface {
+	Unique()
+	FullText()
+	Name(name string)
+	On(table string, col ...string)
+}
+
+type createIndexStmt struct {
+	typ   string
+	name  string
+	table string
+	col   group
+}
+
+func (st *createIndexStmt) Unique() {
+	st.typ = "unique"
+}
+
+func (st *createIndexStmt) FullText() {
+	st.typ = "fulltext"
+}
+
+func (st *createIndexStmt) Name(name string) {
+	st.name = name
+}
+
+func (st *createIndexStmt) On(table string, col ...string) {
+	st.table = table
+	st.col.pushString(col...)
+}
+
+func (st *createIndexStmt) make() *buffer {
+	var b buffer
+	b.push("create")
+	if st.typ != "" {
+		b.push(st.typ)
+	}
+	b.push("index", st.name, "on", st.table, paren(&st.col))
+	return &b
+}
+
+// DropIndex builds drop index statement
+func DropIndex(f func(b DropIndexStatement)) *Result {
+	var st dropIndexStmt
+	f(&st)
+	return newResult(build(st.make()))
+}
+
+// DropIndexStatement is the drop index statement builder
+type DropIndexStatement interface {
+	Name(name string)
+	On(table string)
+}
+
+type dropIndexStmt struct {
+	name  string
+	table string
+}
+
+func (st *dropIndexStmt) Name(name string) {
+	st.name = name
+}
+
+func (st *dropIndexStmt) On(table string) {
+	st.table = table
+}
+
+func (st *dropIndexStmt) make() *buffer {
+	var b buffer
+	b.push("drop index", st.name, "on", st.table)
+	return &b
+}
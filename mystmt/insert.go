@@ -2,9 +2,18 @@ package mystmt
 
 // Insert builds insert statement
 func Insert(f func(b InsertStatement)) *Result {
-	var st insertStmt
-	f(&st)
-	return newResult(build(st.make()))
+	return NewInsert(f).Build()
+}
+
+// InsertBuilder is the reusable insert statement builder, see Builder
+type InsertBuilder = Builder[InsertStatement]
+
+// NewInsert creates new insert statement builder
+func NewInsert(f ...func(b InsertStatement)) *InsertBuilder {
+	return newBuilder(func() (InsertStatement, statement) {
+		var st insertStmt
+		return &st, &st
+	}, f)
 }
 
 // InsertStatement is the insert statement builder
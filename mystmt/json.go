@@ -0,0 +1,231 @@
+package mystmt
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// jsonPath validates path and returns path as string literal,
+// or error when path is invalid
+func jsonPath(path string) interface{} {
+	if err := validateJSONPath(path); err != nil {
+		return errNode{err}
+	}
+	return string(appendQuote(nil, path))
+}
+
+// validateJSONPath validates MySQL JSON path expression, ex. $.a[0].b, $**.c, $[last - 1]
+func validateJSONPath(path string) error {
+	invalid := func() error {
+		return fmt.Errorf("mystmt: invalid json path %q", path)
+	}
+
+	s := strings.TrimSpace(path)
+	if !strings.HasPrefix(s, "$") {
+		return invalid()
+	}
+	s = s[1:]
+	for {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			return nil
+		}
+
+		switch {
+		case strings.HasPrefix(s, "**"):
+			s = strings.TrimLeft(s[2:], " ")
+			// ** must follow by member or array leg
+			if s == "" || strings.HasPrefix(s, "**") {
+				return invalid()
+			}
+		case s[0] == '.':
+			s = strings.TrimLeft(s[1:], " ")
+			n := jsonPathKeyLen(s)
+			if n == 0 {
+				return invalid()
+			}
+			s = s[n:]
+		case s[0] == '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 || !validJSONPathArray(s[1:end]) {
+				return invalid()
+			}
+			s = s[end+1:]
+		default:
+			return invalid()
+		}
+	}
+}
+
+// jsonPathKeyLen returns length of member key at start of s, or 0 if invalid
+func jsonPathKeyLen(s string) int {
+	if s == "" {
+		return 0
+	}
+	if s[0] == '*' {
+		return 1
+	}
+	if s[0] == '"' {
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+			case '"':
+				if i == 1 {
+					return 0
+				}
+				return i + 1
+			}
+		}
+		return 0
+	}
+
+	for i, r := range s {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return i
+	}
+	return len(s)
+}
+
+// validJSONPathArray validates array location, ex. 0, *, last, last - 1, 1 to 3
+func validJSONPathArray(s string) bool {
+	s = strings.TrimSpace(s)
+	if s == "*" {
+		return true
+	}
+	if from, to, ok := strings.Cut(s, " to "); ok {
+		return validJSONPathIndex(from) && validJSONPathIndex(to)
+	}
+	return validJSONPathIndex(s)
+}
+
+func validJSONPathIndex(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "last") {
+		s = strings.TrimSpace(s[4:])
+		if s == "" {
+			return true
+		}
+		if s[0] != '-' {
+			return false
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// JSONExtract builds json_extract(doc, path, ...) expression
+func JSONExtract(doc interface{}, path ...string) Expr {
+	var p parenGroup
+	p.prefix = "json_extract"
+	p.push(doc)
+	for _, x := range path {
+		p.push(jsonPath(x))
+	}
+	return newExpr(&p)
+}
+
+// JSONGet builds col->path expression, returns json value at path
+func JSONGet(col, path string) Expr {
+	return newExpr(jsonArrow(col, "->", path))
+}
+
+// JSONGetText builds col->>path expression, returns unquoted value at path
+func JSONGetText(col, path string) Expr {
+	return newExpr(jsonArrow(col, "->>", path))
+}
+
+func jsonArrow(col, op, path string) *concatGroup {
+	var p concatGroup
+	p.push(col, op, jsonPath(path))
+	return &p
+}
+
+// JSONContains builds json_contains(target, ?, path) expression,
+// candidate is the json document to find in target at optional path
+func JSONContains(target, candidate interface{}, path ...string) Expr {
+	var p parenGroup
+	p.prefix = "json_contains"
+	p.push(target, Arg(candidate))
+	if len(path) > 0 {
+		p.push(jsonPath(path[0]))
+	}
+	if len(path) > 1 {
+		p.push(errorf("mystmt: json_contains accepts only one path"))
+	}
+	return newExpr(&p)
+}
+
+// JSONOverlaps builds json_overlaps(doc, ?) expression
+func JSONOverlaps(doc, value interface{}) Expr {
+	return Func("json_overlaps", doc, Arg(value))
+}
+
+// MemberOf builds ? member of(array) expression
+func MemberOf(value, array interface{}) Expr {
+	var p parenGroup
+	p.prefix = "member of"
+	p.push(array)
+	return newExpr(Arg(value), &p)
+}
+
+// JSONTableColumns is the json_table columns builder
+type JSONTableColumns interface {
+	Ordinality(name string)
+	Column(name, typ, path string)
+	Exists(name, typ, path string)
+	Nested(path string, f func(b JSONTableColumns))
+}
+
+type jsonTableColumns struct {
+	columns group
+}
+
+func (st *jsonTableColumns) Ordinality(name string) {
+	st.columns.push(name + " for ordinality")
+}
+
+func (st *jsonTableColumns) Column(name, typ, path string) {
+	st.columns.push(withGroup(" ", name, typ, "path", jsonPath(path)))
+}
+
+func (st *jsonTableColumns) Exists(name, typ, path string) {
+	st.columns.push(withGroup(" ", name, typ, "exists path", jsonPath(path)))
+}
+
+func (st *jsonTableColumns) Nested(path string, f func(b JSONTableColumns)) {
+	var x jsonTableColumns
+	f(&x)
+	st.columns.push(withGroup(" ", "nested path", jsonPath(path), "columns", paren(&x.columns)))
+}
+
+// jsonTable builds json_table(doc, path columns (...)) as alias,
+// alias is required
+func jsonTable(doc interface{}, path string, f func(b JSONTableColumns), as string) *buffer {
+	var x jsonTableColumns
+	f(&x)
+
+	var p parenGroup
+	p.prefix = "json_table"
+	p.push(doc, withGroup(" ", jsonPath(path), "columns", paren(&x.columns)))
+
+	var b buffer
+	b.push(&p)
+	if as == "" {
+		b.push(errorf("mystmt: json_table requires alias"))
+	} else {
+		b.push(as)
+	}
+	return &b
+}
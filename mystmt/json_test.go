@@ -0,0 +1,198 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"extract",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(
+					mystmt.JSONExtract("doc", "$.name", "$.tags[0]"),
+					mystmt.JSONGet("doc", "$.address.city"),
+					mystmt.As(mystmt.JSONGetText("doc", `$."first name"`), "first_name"),
+				)
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq(mystmt.JSONGetText("doc", "$.status"), "active")
+				})
+			}),
+			`
+				select json_extract(doc, '$.name', '$.tags[0]'),
+					doc->'$.address.city',
+					doc->>'$.\"first name\"' first_name
+				from users
+				where (doc->>'$.status' = ?)
+			`,
+			[]interface{}{"active"},
+		},
+		{
+			"predicates",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("products")
+				b.Where(func(b mystmt.Cond) {
+					b.JSONContains("tags", `["sale"]`)
+					b.JSONContains("doc", `1`, "$.ids")
+					b.JSONOverlaps("tags", `["a", "b"]`)
+					b.MemberOf(10, mystmt.JSONGet("doc", "$.ids"))
+				})
+			}),
+			`
+				select * from products
+				where (json_contains(tags, ?)
+					and json_contains(doc, ?, '$.ids')
+					and json_overlaps(tags, ?)
+					and ? member of(doc->'$.ids'))
+			`,
+			[]interface{}{`["sale"]`, `1`, `["a", "b"]`, 10},
+		},
+		{
+			"json table",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("o.id", "i.*")
+				b.From("orders o")
+				b.JoinJSONTable("o.items", "$[*]", func(b mystmt.JSONTableColumns) {
+					b.Ordinality("seq")
+					b.Column("sku", "varchar(64)", "$.sku")
+					b.Exists("has_qty", "int", "$.qty")
+					b.Nested("$.options[*]", func(b mystmt.JSONTableColumns) {
+						b.Column("opt", "varchar(32)", "$")
+					})
+				}, "i")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("o.id", 1)
+				})
+			}),
+			`
+				select o.id, i.*
+				from orders o
+				join json_table(o.items, '$[*]' columns (seq for ordinality,
+					sku varchar(64) path '$.sku',
+					has_qty int exists path '$.qty',
+					nested path '$.options[*]' columns (opt varchar(32) path '$'))) i
+				where (o.id = ?)
+			`,
+			[]interface{}{1},
+		},
+		{
+			"from json table",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.FromJSONTable(mystmt.Arg(`[1, 2]`), "$[*]", func(b mystmt.JSONTableColumns) {
+					b.Column("x", "int", "$")
+				}, "t")
+			}),
+			`
+				select * from json_table(?, '$[*]' columns (x int path '$')) t
+			`,
+			[]interface{}{`[1, 2]`},
+		},
+		{
+			"update json set remove",
+			mystmt.Update(func(b mystmt.UpdateStatement) {
+				b.Table("users")
+				b.Set("doc").
+					JSONSet("$.name", "tester").
+					JSONSet("$.age", 20).
+					JSONRemove("$.tmp", "$.tags[last]")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("id", 1)
+				})
+			}),
+			`
+				update users
+				set doc = json_remove(json_set(doc, '$.name', ?, '$.age', ?), '$.tmp', '$.tags[last]')
+				where (id = ?)
+			`,
+			[]interface{}{"tester", 20, 1},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("json table without alias", func(t *testing.T) {
+		columns := func(b mystmt.JSONTableColumns) {
+			b.Column("x", "int", "$")
+		}
+
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.FromJSONTable(mystmt.Arg(`[1, 2]`), "$[*]", columns, "")
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users u")
+			b.JoinJSONTable("u.doc", "$[*]", columns, "").On(func(b mystmt.Cond) {
+				b.Raw("true")
+			})
+		})
+		assert.Error(t, r.Err())
+	})
+}
+
+func TestJSONPath(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"$",
+		"$.a",
+		"$.a.b_c",
+		"$.*",
+		`$."a b"`,
+		"$[0]",
+		"$[*]",
+		"$[last]",
+		"$[last - 1]",
+		"$[1 to 3]",
+		"$**.a",
+		"$.a[0].b[*]",
+	}
+	for _, p := range valid {
+		assert.NoError(t, jsonPathErr(p), p)
+	}
+
+	invalid := []string{
+		"",
+		"a",
+		"$.",
+		"$.1a",
+		"$[",
+		"$[a]",
+		"$[-1]",
+		"$**",
+		`$.""`,
+		"$.a'); drop table users; --",
+	}
+	for _, p := range invalid {
+		assert.Error(t, jsonPathErr(p), p)
+	}
+}
+
+func jsonPathErr(path string) error {
+	return mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns(mystmt.JSONGet("doc", path))
+	}).Err()
+}
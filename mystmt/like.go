@@ -0,0 +1,20 @@
+package mystmt
+
+import (
+	"strings"
+)
+
+// likeEscape is the escape character for like pattern,
+// not use backslash to work with NO_BACKSLASH_ESCAPES
+const likeEscape = "!"
+
+var likeEscaper = strings.NewReplacer(
+	likeEscape, likeEscape+likeEscape,
+	"%", likeEscape+"%",
+	"_", likeEscape+"_",
+)
+
+// EscapeLike escapes like wildcards in s, the pattern must use escape '!'
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
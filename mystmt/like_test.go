@@ -0,0 +1,42 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestLike(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "100!% a!_b !!", mystmt.EscapeLike("100% a_b !"))
+
+	q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns("*")
+		b.From("users")
+		b.Where(func(b mystmt.Cond) {
+			b.Contains("name", "50%")
+			b.StartsWith("email", "a_b")
+			b.EndsWith("email", "@x.com")
+			b.ContainsFold("name", "Tester", "utf8mb4_0900_ai_ci")
+			b.StartsWithFold("name", "T", "utf8mb4_general_ci")
+			b.EndsWithFold("name", "R", "utf8mb4_general_ci")
+		})
+	}).SQL()
+
+	assert.Equal(t,
+		stripSpace(`
+			select * from users
+			where (name like ? escape '!'
+				and email like ? escape '!'
+				and email like ? escape '!'
+				and name collate utf8mb4_0900_ai_ci like ? escape '!'
+				and name collate utf8mb4_general_ci like ? escape '!'
+				and name collate utf8mb4_general_ci like ? escape '!')
+		`),
+		q,
+	)
+	assert.EqualValues(t, []interface{}{"%50!%%", "a!_b%", "%@x.com", "%Tester%", "T%", "%R"}, args)
+}
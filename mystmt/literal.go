@@ -0,0 +1,140 @@
+package mystmt
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// appendLiteral appends v as MySQL literal to buf
+func appendLiteral(buf []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(buf, "NULL"...), nil
+	case string:
+		return appendQuote(buf, v), nil
+	case []byte:
+		if v == nil {
+			return append(buf, "NULL"...), nil
+		}
+		buf = append(buf, "X'"...)
+		buf = append(buf, hex.EncodeToString(v)...)
+		return append(buf, '\''), nil
+	case bool:
+		return strconv.AppendBool(buf, v), nil
+	case int:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int8:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(buf, v, 10), nil
+	case uint:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint8:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(buf, v, 10), nil
+	case float32:
+		return appendFloat(buf, float64(v), 32)
+	case float64:
+		return appendFloat(buf, v, 64)
+	case time.Time:
+		buf = append(buf, '\'')
+		if v.IsZero() {
+			buf = append(buf, "0000-00-00 00:00:00"...)
+		} else {
+			buf = v.AppendFormat(buf, "2006-01-02 15:04:05.999999")
+		}
+		return append(buf, '\''), nil
+	case driver.Valuer:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return append(buf, "NULL"...), nil
+		}
+		x, err := v.Value()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := x.(driver.Valuer); ok {
+			return nil, fmt.Errorf("mystmt: literal not support value %T", v)
+		}
+		return appendLiteral(buf, x)
+	}
+
+	// named types
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return append(buf, "NULL"...), nil
+		}
+		return appendLiteral(buf, rv.Elem().Interface())
+	case reflect.String:
+		return appendLiteral(buf, rv.String())
+	case reflect.Bool:
+		return appendLiteral(buf, rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return appendLiteral(buf, rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return appendLiteral(buf, rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return appendLiteral(buf, rv.Float())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return appendLiteral(buf, rv.Bytes())
+		}
+	}
+	return nil, fmt.Errorf("mystmt: literal not support value %T", v)
+}
+
+func appendFloat(buf []byte, v float64, bitSize int) ([]byte, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("mystmt: literal not support value %v", v)
+	}
+	return strconv.AppendFloat(buf, v, 'g', -1, bitSize), nil
+}
+
+// appendQuote appends quoted string literal to buf
+func appendQuote(buf []byte, s string) []byte {
+	buf = append(buf, '\'')
+	if NoBackslashEscapes {
+		for i := 0; i < len(s); i++ {
+			if s[i] == '\'' {
+				buf = append(buf, '\'')
+			}
+			buf = append(buf, s[i])
+		}
+		return append(buf, '\'')
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case 0:
+			buf = append(buf, '\\', '0')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\x1a':
+			buf = append(buf, '\\', 'Z')
+		case '\'', '"', '\\':
+			buf = append(buf, '\\', c)
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return append(buf, '\'')
+}
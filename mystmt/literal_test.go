@@ -0,0 +1,64 @@
+package mystmt_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestLiteral(t *testing.T) {
+	t.Parallel()
+
+	type status string
+
+	cases := []struct {
+		value  interface{}
+		result string
+	}{
+		{nil, "NULL"},
+		{"O'Brien", `'O\'Brien'`},
+		{"a\\b\"c\nd\re\x00f\x1ag", `'a\\b\"c\nd\re\0f\Zg'`},
+		{status("active"), "'active'"},
+		{[]byte("abc"), "X'616263'"},
+		{[]byte{}, "X''"},
+		{[]byte(nil), "NULL"},
+		{json.RawMessage(`{}`), "X'7b7d'"},
+		{time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.UTC), "'2020-01-02 03:04:05.6'"},
+		{time.Time{}, "'0000-00-00 00:00:00'"},
+		{-1, "-1"},
+		{uint32(2), "2"},
+		{1.25, "1.25"},
+		{true, "true"},
+		{sql.NullString{String: "a", Valid: true}, "'a'"},
+		{sql.NullInt64{}, "NULL"},
+		{(*int)(nil), "NULL"},
+	}
+
+	for _, c := range cases {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(mystmt.NotArg(c.value))
+		}).SQL()
+		assert.Equal(t, "select "+c.result, q)
+		assert.Empty(t, args)
+	}
+
+	r := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns(mystmt.NotArg(struct{}{}))
+	})
+	assert.Error(t, r.Err())
+}
+
+func TestLiteralNoBackslashEscapes(t *testing.T) {
+	defer func(v bool) { mystmt.NoBackslashEscapes = v }(mystmt.NoBackslashEscapes)
+	mystmt.NoBackslashEscapes = true
+
+	q, _ := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns(mystmt.NotArg(`O'Brien\n`))
+	}).SQL()
+	assert.Equal(t, `select 'O''Brien\n'`, q)
+}
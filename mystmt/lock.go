@@ -0,0 +1,57 @@
+package mystmt
+
+// Lock is the locking read clause builder.
+//
+// Of requires for update or for share in MySQL 8.0,
+// NoWait and SkipLocked also support by MariaDB,
+// unsupported options returns error from Result.Err
+type Lock interface {
+	Of(table ...string) Lock
+	NoWait() Lock
+	SkipLocked() Lock
+}
+
+type lock struct {
+	strength string // update, share, lock in share mode
+	of       group
+	option   string
+}
+
+func (st *lock) Of(table ...string) Lock {
+	st.of.pushString(table...)
+	return st
+}
+
+func (st *lock) NoWait() Lock {
+	st.option = "nowait"
+	return st
+}
+
+func (st *lock) SkipLocked() Lock {
+	st.option = "skip locked"
+	return st
+}
+
+func (st *lock) build() []interface{} {
+	legacy := st.strength == "lock in share mode" || (st.strength == "share" && DefaultDialect != MySQL80)
+
+	var b buffer
+	if legacy {
+		b.push("lock in share mode")
+	} else {
+		b.push("for", st.strength)
+	}
+	if !st.of.empty() {
+		if legacy || DefaultDialect != MySQL80 {
+			b.push(errorf("mystmt: lock of requires MySQL 8.0 for update or for share"))
+		}
+		b.push("of", &st.of)
+	}
+	if st.option != "" {
+		if DefaultDialect == MySQL57 || (legacy && DefaultDialect == MySQL80) {
+			b.push(errorf("mystmt: lock %s requires MySQL 8.0 for update or for share, or MariaDB", st.option))
+		}
+		b.push(st.option)
+	}
+	return b.q
+}
@@ -0,0 +1,169 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestLock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"for update",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("value")
+				b.From("accounts")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("id", 1)
+				})
+				b.ForUpdate()
+			}),
+			"select value from accounts where (id = ?) for update",
+			[]interface{}{1},
+		},
+		{
+			"for update skip locked",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id", "payload")
+				b.From("jobs")
+				b.Where(func(b mystmt.Cond) {
+					b.IsNull("taken_at")
+				})
+				b.OrderBy("id")
+				b.Limit(10)
+				b.ForUpdate().SkipLocked()
+			}),
+			"select id, payload from jobs where (taken_at is null) order by id limit 10 for update skip locked",
+			nil,
+		},
+		{
+			"for share of nowait",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users u")
+				b.Join("roles r").Using("role_id")
+				b.ForShare().Of("u", "r").NoWait()
+			}),
+			"select * from users u join roles r using (role_id) for share of u, r nowait",
+			nil,
+		},
+		{
+			"multiple locks",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("orders o")
+				b.Join("users u").Using("user_id")
+				b.ForUpdate().Of("o")
+				b.ForShare().Of("u")
+			}),
+			"select * from orders o join users u using (user_id) for update of o for share of u",
+			nil,
+		},
+		{
+			"lock in share mode",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.LockInShareMode()
+			}),
+			"select * from users lock in share mode",
+			nil,
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("lock in share mode options", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("jobs")
+			b.LockInShareMode().Of("jobs")
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("jobs")
+			b.LockInShareMode().NoWait()
+		})
+		assert.Error(t, r.Err())
+	})
+}
+
+func TestLockDialect(t *testing.T) {
+	defer func(d mystmt.Dialect) { mystmt.DefaultDialect = d }(mystmt.DefaultDialect)
+
+	for _, d := range []mystmt.Dialect{mystmt.MySQL57, mystmt.MariaDB} {
+		mystmt.DefaultDialect = d
+
+		q, _ := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users")
+			b.ForShare()
+		}).SQL()
+		assert.Equal(t, "select * from users lock in share mode", q)
+
+		q, _ = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users")
+			b.ForUpdate()
+		}).SQL()
+		assert.Equal(t, "select * from users for update", q)
+
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users")
+			b.ForUpdate().Of("users")
+		})
+		assert.Error(t, r.Err())
+	}
+
+	mystmt.DefaultDialect = mystmt.MySQL57
+	for _, f := range []func(b mystmt.SelectStatement){
+		func(b mystmt.SelectStatement) { b.ForUpdate().SkipLocked() },
+		func(b mystmt.SelectStatement) { b.ForUpdate().NoWait() },
+		func(b mystmt.SelectStatement) { b.ForShare().NoWait() },
+	} {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("jobs")
+			f(b)
+		})
+		assert.Error(t, r.Err())
+	}
+
+	mystmt.DefaultDialect = mystmt.MariaDB
+	r := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns("*")
+		b.From("jobs")
+		b.ForUpdate().SkipLocked()
+	})
+	q, _ := r.SQL()
+	assert.NoError(t, r.Err())
+	assert.Equal(t, "select * from jobs for update skip locked", q)
+
+	r = mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns("*")
+		b.From("jobs")
+		b.ForShare().NoWait()
+	})
+	q, _ = r.SQL()
+	assert.NoError(t, r.Err())
+	assert.Equal(t, "select * from jobs lock in share mode nowait", q)
+}
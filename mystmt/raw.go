@@ -0,0 +1,97 @@
+package mystmt
+
+// RawArgs builds raw sql expression, each ? placeholder in sql
+// will be replaced by the argument at the same position
+func RawArgs(sql string, args ...interface{}) Expr {
+	var i int
+	q := parseRaw(sql, '?', func(string) interface{} {
+		if i >= len(args) {
+			return errorf("mystmt: raw sql has more placeholders than %d args", len(args))
+		}
+		v := args[i]
+		i++
+		return Arg(v)
+	})
+	if i != len(args) {
+		q.push(errorf("mystmt: raw sql has %d placeholders but got %d args", i, len(args)))
+	}
+	return newExpr(q)
+}
+
+// RawNamed builds raw sql expression, each :name placeholder in sql
+// will be replaced by the argument from params
+func RawNamed(sql string, params map[string]interface{}) Expr {
+	q := parseRaw(sql, ':', func(name string) interface{} {
+		v, ok := params[name]
+		if !ok {
+			return errorf("mystmt: raw sql missing param %s", name)
+		}
+		return Arg(v)
+	})
+	return newExpr(q)
+}
+
+// parseRaw splits sql into string and argument fragments,
+// placeholders inside quoted string and identifier are ignored
+func parseRaw(sql string, placeholder byte, arg func(name string) interface{}) *concatGroup {
+	var (
+		q     concatGroup
+		start int
+		quote byte
+	)
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		if quote != 0 {
+			switch c {
+			case '\\':
+				if quote != '`' && !NoBackslashEscapes {
+					i++
+				}
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '\'', '"', '`':
+			quote = c
+			continue
+		case placeholder:
+		default:
+			continue
+		}
+
+		if placeholder == '?' {
+			if start < i {
+				q.push(sql[start:i])
+			}
+			q.push(arg(""))
+			start = i + 1
+			continue
+		}
+
+		// named placeholder, skip := and ::
+		n := i + 1
+		for n < len(sql) && isNameChar(sql[n]) {
+			n++
+		}
+		if n == i+1 || (i > 0 && sql[i-1] == ':') {
+			continue
+		}
+		if start < i {
+			q.push(sql[start:i])
+		}
+		q.push(arg(sql[i+1 : n]))
+		start = n
+		i = n - 1
+	}
+	if start < len(sql) {
+		q.push(sql[start:])
+	}
+	return &q
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,117 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestRaw(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"cond raw args",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("tenant_id", 1)
+					b.Raw("(name like concat(?, '%') or email = ?)", "te", "test@localhost")
+					b.Eq("is_active", true)
+				})
+			}),
+			"select id from users where (tenant_id = ? and (name like concat(?, '%') or email = ?) and is_active = ?)",
+			[]interface{}{1, "te", "test@localhost", true},
+		},
+		{
+			"cond raw without args",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Raw("name = '?'")
+				})
+			}),
+			"select id from users where (name = '?')",
+			nil,
+		},
+		{
+			"op raw and set to raw",
+			mystmt.Update(func(b mystmt.UpdateStatement) {
+				b.Table("users")
+				b.Set("name").ToRaw(mystmt.RawArgs("concat(name, ?)", "-x"))
+				b.Set("score").ToRaw(mystmt.RawArgs("least(score + ?, ?)", 1, 100))
+				b.Where(func(b mystmt.Cond) {
+					b.GtRaw("updated_at", mystmt.RawArgs("now() - interval ? day", 7))
+				})
+			}),
+			"update users set name = concat(name, ?), score = least(score + ?, ?) where (updated_at > now() - interval ? day)",
+			[]interface{}{"-x", 1, 100, 7},
+		},
+		{
+			"raw args skip quoted placeholder",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.RawArgs("if(a = '?', \"?\", `?`) + ?", 1))
+			}),
+			"select if(a = '?', \"?\", `?`) + ?",
+			[]interface{}{1},
+		},
+		{
+			"raw args with expression",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.RawArgs("coalesce(?, ?)", mystmt.Col("nickname"), "anonymous"))
+				b.From("users")
+			}),
+			"select coalesce(nickname, ?) from users",
+			[]interface{}{"anonymous"},
+		},
+		{
+			"raw named",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.OpRaw("created_at", "between", mystmt.RawNamed(":from and :to", map[string]interface{}{
+						"from": "2020-01-01",
+						"to":   "2021-01-01",
+					}))
+					b.Raw("x = 1")
+					b.EqRaw("name", mystmt.RawNamed("coalesce(:name, ':name', @v := 1, :name)", map[string]interface{}{
+						"name": "test",
+					}))
+				})
+			}),
+			"select id from users where (created_at between ? and ? and x = 1 and name = coalesce(?, ':name', @v := 1, ?))",
+			[]interface{}{"2020-01-01", "2021-01-01", "test", "test"},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+		})
+	}
+
+	t.Run("args mismatch", func(t *testing.T) {
+		for _, x := range []mystmt.Expr{
+			mystmt.RawArgs("a = ? and b = ?", 1),
+			mystmt.RawArgs("a = ?", 1, 2),
+			mystmt.RawNamed("a = :a", nil),
+		} {
+			r := mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(x)
+			})
+			assert.Error(t, r.Err())
+		}
+	})
+}
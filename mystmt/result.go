@@ -3,6 +3,12 @@ package mystmt
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/acoshift/mysql"
 	"github.com/acoshift/mysql/myctx"
@@ -11,52 +17,245 @@ import (
 type Result struct {
 	query string
 	args  []interface{}
+	err   error
 }
 
-func newResult(query string, args []interface{}) *Result {
-	return &Result{query, args}
+func newResult(query string, args []interface{}, err error) *Result {
+	return &Result{query, args, err}
 }
 
-func (r *Result) SQL() (query string, args interface{}) {
+// SQL returns query and arguments,
+// query is not valid when Err returns error
+func (r *Result) SQL() (query string, args []interface{}) {
 	return r.query, r.args
 }
 
+// Err returns the first error found while building statement
+func (r *Result) Err() error {
+	return r.err
+}
+
+// check returns error when statement can not execute
+func (r *Result) check() error {
+	if r.err != nil {
+		return r.err
+	}
+	for _, v := range r.args {
+		if p, ok := v.(param); ok {
+			return fmt.Errorf("mystmt: param %s is not bound, use Compile and Bind", p.name)
+		}
+	}
+	return nil
+}
+
+// errArg fails argument conversion in database/sql,
+// use to return error from *sql.Row before send query
+type errArg struct {
+	err error
+}
+
+func (x errArg) Value() (driver.Value, error) {
+	return nil, x.err
+}
+
+// Args returns statement arguments
+func (r *Result) Args() []interface{} {
+	return r.args
+}
+
+// Debug returns query with arguments interpolated as the driver's
+// interpolateParams with default config (loc=UTC), ex. bool as 1 or 0,
+// []byte as _binary string, time in UTC.
+// Use for debugging and logging only
+func (r *Result) Debug() (string, error) {
+	if r.err != nil {
+		return "", r.err
+	}
+
+	var (
+		i   int
+		err error
+	)
+	q := parseRaw(r.query, '?', func(string) interface{} {
+		if i >= len(r.args) {
+			if err == nil {
+				err = fmt.Errorf("mystmt: query has more placeholders than %d args", len(r.args))
+			}
+			return "?"
+		}
+		v := r.args[i]
+		i++
+		if p, ok := v.(param); ok {
+			return ":" + p.name
+		}
+		b, e := appendArg(nil, v)
+		if e != nil && err == nil {
+			err = e
+		}
+		return string(b)
+	})
+	if err != nil {
+		return "", err
+	}
+	if i != len(r.args) {
+		return "", fmt.Errorf("mystmt: query has %d placeholders but got %d args", i, len(r.args))
+	}
+	s, _, _ := build(&buffer{[]interface{}{q}})
+	return s, nil
+}
+
+// appendArg appends v as the driver interpolates argument
+func appendArg(buf []byte, v interface{}) ([]byte, error) {
+	v, err := driverValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return append(buf, '1'), nil
+		}
+		return append(buf, '0'), nil
+	case float64:
+		return strconv.AppendFloat(buf, v, 'g', -1, 64), nil
+	case time.Time:
+		if v.IsZero() {
+			return append(buf, "'0000-00-00'"...), nil
+		}
+		return appendLiteral(buf, v.UTC())
+	case json.RawMessage:
+		return appendQuote(buf, string(v)), nil
+	case []byte:
+		if v == nil {
+			return append(buf, "NULL"...), nil
+		}
+		buf = append(buf, "_binary"...)
+		return appendQuote(buf, string(v)), nil
+	}
+	return appendLiteral(buf, v)
+}
+
+// driverValue converts v to driver value like the driver's converter
+func driverValue(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil, int64, uint64, float64, bool, []byte, string, time.Time, json.RawMessage:
+		return v, nil
+	case driver.Valuer:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, nil
+		}
+		x, err := v.(driver.Valuer).Value()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := x.(driver.Valuer); ok {
+			return nil, fmt.Errorf("mystmt: debug not support value %T", v)
+		}
+		return driverValue(x)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return driverValue(rv.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+	}
+	return nil, fmt.Errorf("mystmt: debug not support value %T", v)
+}
+
+// String returns interpolated query, see Debug
+func (r *Result) String() string {
+	s, err := r.Debug()
+	if err != nil {
+		return r.query + " -- " + err.Error()
+	}
+	return s
+}
+
+// rowArgs returns arguments for query row,
+// *sql.Row can not create with error so the error returns from Scan instead
+func (r *Result) rowArgs() []interface{} {
+	if err := r.check(); err != nil {
+		return []interface{}{errArg{err}}
+	}
+	return r.args
+}
+
 func (r *Result) QueryRow(f func(string, ...interface{}) *sql.Row) *sql.Row {
-	return f(r.query, r.args...)
+	return f(r.query, r.rowArgs()...)
 }
 
 func (r *Result) Query(f func(string, ...interface{}) (*sql.Rows, error)) (*sql.Rows, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return f(r.query, r.args...)
 }
 
 func (r *Result) Exec(f func(string, ...interface{}) (sql.Result, error)) (sql.Result, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return f(r.query, r.args...)
 }
 
 func (r *Result) QueryRowContext(ctx context.Context, f func(context.Context, string, ...interface{}) *sql.Row) *sql.Row {
-	return f(ctx, r.query, r.args...)
+	return f(ctx, r.query, r.rowArgs()...)
 }
 
 func (r *Result) QueryContext(ctx context.Context, f func(context.Context, string, ...interface{}) (*sql.Rows, error)) (*sql.Rows, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return f(ctx, r.query, r.args...)
 }
 
 func (r *Result) ExecContext(ctx context.Context, f func(context.Context, string, ...interface{}) (sql.Result, error)) (sql.Result, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return f(ctx, r.query, r.args...)
 }
 
 func (r *Result) QueryRowWith(ctx context.Context) *sql.Row {
-	return myctx.QueryRow(ctx, r.query, r.args...)
+	return myctx.QueryRow(ctx, r.query, r.rowArgs()...)
 }
 
 func (r *Result) QueryWith(ctx context.Context) (*sql.Rows, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return myctx.Query(ctx, r.query, r.args...)
 }
 
 func (r *Result) ExecWith(ctx context.Context) (sql.Result, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
 	return myctx.Exec(ctx, r.query, r.args...)
 }
 
 func (r *Result) IterWith(ctx context.Context, iter mysql.Iterator) error {
+	if err := r.check(); err != nil {
+		return err
+	}
 	return myctx.Iter(ctx, iter, r.query, r.args...)
 }
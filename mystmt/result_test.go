@@ -0,0 +1,127 @@
+package mystmt_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestResultDebug(t *testing.T) {
+	t.Parallel()
+
+	t.Run("interpolate", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("id", mystmt.NotArg("what?"))
+			b.From("users")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("name", "O'Brien")
+				b.Eq("data", []byte{0x01, 0xff})
+				b.Gt("created_at", time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+				b.Eq("id", mystmt.Param("id"))
+				b.IsNull("deleted_at")
+			})
+			b.Limit(10)
+		})
+
+		q, err := r.Debug()
+		assert.NoError(t, err)
+		assert.Equal(t,
+			`select id, 'what?' from users where (name = 'O\'Brien' and data = _binary'`+"\x01\xff"+`' and created_at > '2020-01-02 03:04:05' and id = :id and deleted_at is null) limit 10`,
+			q,
+		)
+		assert.Equal(t, q, r.String())
+		assert.Equal(t, q, fmt.Sprint(r))
+		assert.Len(t, r.Args(), 4)
+	})
+
+	t.Run("driver values", func(t *testing.T) {
+		type flag bool
+
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("is_active", true)
+				b.Eq("is_admin", flag(false))
+				b.Eq("score", float32(1.5))
+				b.Eq("data", json.RawMessage(`{"a":1}`))
+				b.Eq("created_at", time.Date(2020, 1, 2, 10, 4, 5, 120000000, time.FixedZone("", 7*60*60)))
+				b.Eq("deleted_at", time.Time{})
+				b.Eq("token", []byte(nil))
+			})
+		})
+
+		q, err := r.Debug()
+		assert.NoError(t, err)
+		assert.Equal(t,
+			stripSpace(`
+				select * from users where (is_active = 1 and is_admin = 0 and score = 1.5 and data = '{\"a\":1}'
+				and created_at = '2020-01-02 03:04:05.12' and deleted_at = '0000-00-00' and token = NULL)
+			`),
+			q,
+		)
+	})
+
+	t.Run("unsupported value", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("users")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("id", struct{}{})
+			})
+		})
+
+		_, err := r.Debug()
+		assert.Error(t, err)
+		assert.Contains(t, r.String(), "select * from users where (id = ?) -- ")
+	})
+}
+
+func TestResultErr(t *testing.T) {
+	t.Parallel()
+
+	r := mystmt.Select(func(b mystmt.SelectStatement) {
+		b.Columns(mystmt.NotArg(struct{}{}))
+	})
+	assert.Error(t, r.Err())
+
+	_, err := r.Debug()
+	assert.ErrorIs(t, err, r.Err())
+
+	_, err = r.Compile().Bind(nil)
+	assert.ErrorIs(t, err, r.Err())
+
+	called := false
+	_, err = r.Exec(func(string, ...interface{}) (sql.Result, error) {
+		called = true
+		return nil, nil
+	})
+	assert.ErrorIs(t, err, r.Err())
+	assert.False(t, called)
+
+	_, err = r.QueryContext(context.Background(), func(context.Context, string, ...interface{}) (*sql.Rows, error) {
+		called = true
+		return nil, nil
+	})
+	assert.ErrorIs(t, err, r.Err())
+	assert.False(t, called)
+
+	db, mock, err := sqlmock.New()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer db.Close()
+
+	var x int
+	err = r.QueryRow(db.QueryRow).Scan(&x)
+	assert.ErrorIs(t, err, r.Err())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
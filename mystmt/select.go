@@ -2,19 +2,32 @@ package mystmt
 
 // Select builds select statement
 func Select(f func(b SelectStatement)) *Result {
-	var st selectStmt
-	f(&st)
-	return newResult(build(st.make()))
+	return NewSelect(f).Build()
+}
+
+// SelectBuilder is the reusable select statement builder, see Builder
+type SelectBuilder = Builder[SelectStatement]
+
+// NewSelect creates new select statement builder
+func NewSelect(f ...func(b SelectStatement)) *SelectBuilder {
+	return newBuilder(func() (SelectStatement, statement) {
+		var st selectStmt
+		return &st, &st
+	}, f)
 }
 
 // SelectStatement is the select statement builder
 type SelectStatement interface {
+	OptimizerHint(hint ...string)
 	Distinct() Distinct
+	Modifier(m ...SelectModifier)
 	Columns(col ...interface{})
 	ColumnSelect(f func(b SelectStatement), as string)
-	From(table ...string)
+	From(table ...string) Table
 	FromSelect(f func(b SelectStatement), as string)
+	FromJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string)
 	Join(table string) Join
+	StraightJoin(table string) Join
 	InnerJoin(table string) Join
 	FullOuterJoin(table string) Join
 	LeftJoin(table string) Join
@@ -33,12 +46,19 @@ type SelectStatement interface {
 	FullOuterJoinLateralSelect(f func(b SelectStatement), as string) Join
 	LeftJoinLateralSelect(f func(b SelectStatement), as string) Join
 	RightJoinLateralSelect(f func(b SelectStatement), as string) Join
+	JoinJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string) Join
+	LeftJoinJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string) Join
 	Where(f func(b Cond))
-	GroupBy(col ...string)
+	GroupBy(col ...string) GroupBy
+	GroupByExpr(col ...interface{}) GroupBy
 	Having(f func(b Cond))
-	OrderBy(col string) OrderBy
+	Window(name string, f func(b Window))
+	OrderBy(col interface{}) OrderBy
 	Limit(n int64)
 	Offset(n int64)
+	ForUpdate() Lock
+	ForShare() Lock
+	LockInShareMode() Lock
 }
 
 type Distinct interface {
@@ -57,22 +77,35 @@ type OrderBy interface {
 	NullsLast() OrderBy
 }
 
+type GroupBy interface {
+	WithRollup()
+}
+
 type Join interface {
+	Table
 	On(f func(b Cond))
 	Using(col ...string)
 }
 
 type selectStmt struct {
-	distinct *distinct
-	columns  group
-	from     group
-	joins    buffer
-	where    cond
-	groupBy  group
-	having   cond
-	orderBy  group
-	limit    *int64
-	offset   *int64
+	hints     optimizerHints
+	distinct  *distinct
+	modifiers group
+	columns   group
+	from      group
+	joins     buffer
+	where     cond
+	groupBy   groupBy
+	having    cond
+	windows   group
+	orderBy   group
+	limit     *int64
+	offset    *int64
+	locks     group
+}
+
+func (st *selectStmt) OptimizerHint(hint ...string) {
+	st.hints.push(hint...)
 }
 
 func (st *selectStmt) Distinct() Distinct {
@@ -80,6 +113,12 @@ func (st *selectStmt) Distinct() Distinct {
 	return st.distinct
 }
 
+func (st *selectStmt) Modifier(m ...SelectModifier) {
+	for _, x := range m {
+		st.modifiers.push(string(x))
+	}
+}
+
 func (st *selectStmt) Columns(col ...interface{}) {
 	st.columns.push(col...)
 }
@@ -96,8 +135,17 @@ func (st *selectStmt) ColumnSelect(f func(b SelectStatement), as string) {
 	st.columns.push(&b)
 }
 
-func (st *selectStmt) From(table ...string) {
-	st.from.pushString(table...)
+func (st *selectStmt) From(table ...string) Table {
+	// index hints apply to the last table
+	var x *tableRef
+	for _, t := range table {
+		x = &tableRef{name: t}
+		st.from.push(x)
+	}
+	if x == nil {
+		return &indexHints{}
+	}
+	return x
 }
 
 func (st *selectStmt) FromSelect(f func(b SelectStatement), as string) {
@@ -112,6 +160,10 @@ func (st *selectStmt) FromSelect(f func(b SelectStatement), as string) {
 	st.from.push(&b)
 }
 
+func (st *selectStmt) FromJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string) {
+	st.from.push(jsonTable(doc, path, f, as))
+}
+
 func (st *selectStmt) join(typ, table string) Join {
 	var b buffer
 	b.push(table)
@@ -127,6 +179,10 @@ func (st *selectStmt) Join(table string) Join {
 	return st.join("join", table)
 }
 
+func (st *selectStmt) StraightJoin(table string) Join {
+	return st.join("straight_join", table)
+}
+
 func (st *selectStmt) InnerJoin(table string) Join {
 	return st.join("inner join", table)
 }
@@ -154,8 +210,9 @@ func (st *selectStmt) joinSelect(typ string, f func(b SelectStatement), as strin
 	}
 
 	j := join{
-		typ:   typ,
-		table: &b,
+		typ:     typ,
+		table:   &b,
+		derived: true,
 	}
 	st.joins.push(&j)
 	return &j
@@ -172,13 +229,32 @@ func (st *selectStmt) joinUnion(typ string, f func(b UnionStatement), as string)
 	}
 
 	j := join{
-		typ:   typ,
-		table: &b,
+		typ:     typ,
+		table:   &b,
+		derived: true,
+	}
+	st.joins.push(&j)
+	return &j
+}
+
+func (st *selectStmt) joinJSONTable(typ string, doc interface{}, path string, f func(b JSONTableColumns), as string) Join {
+	j := join{
+		typ:     typ,
+		table:   jsonTable(doc, path, f, as),
+		derived: true,
 	}
 	st.joins.push(&j)
 	return &j
 }
 
+func (st *selectStmt) JoinJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string) Join {
+	return st.joinJSONTable("join", doc, path, f, as)
+}
+
+func (st *selectStmt) LeftJoinJSONTable(doc interface{}, path string, f func(b JSONTableColumns), as string) Join {
+	return st.joinJSONTable("left join", doc, path, f, as)
+}
+
 func (st *selectStmt) JoinSelect(f func(b SelectStatement), as string) Join {
 	return st.joinSelect("join", f, as)
 }
@@ -239,15 +315,29 @@ func (st *selectStmt) Where(f func(b Cond)) {
 	f(&st.where)
 }
 
-func (st *selectStmt) GroupBy(col ...string) {
+func (st *selectStmt) GroupBy(col ...string) GroupBy {
 	st.groupBy.pushString(col...)
+	return &st.groupBy
+}
+
+func (st *selectStmt) GroupByExpr(col ...interface{}) GroupBy {
+	st.groupBy.push(col...)
+	return &st.groupBy
 }
 
 func (st *selectStmt) Having(f func(b Cond)) {
 	f(&st.having)
 }
 
-func (st *selectStmt) OrderBy(col string) OrderBy {
+func (st *selectStmt) Window(name string, f func(b Window)) {
+	x := namedWindow{
+		name: name,
+	}
+	f(&x.window)
+	st.windows.push(&x)
+}
+
+func (st *selectStmt) OrderBy(col interface{}) OrderBy {
 	p := orderBy{
 		col: col,
 	}
@@ -263,9 +353,32 @@ func (st *selectStmt) Offset(n int64) {
 	st.offset = &n
 }
 
+func (st *selectStmt) lock(strength string) Lock {
+	x := lock{
+		strength: strength,
+	}
+	st.locks.push(&x)
+	return &x
+}
+
+func (st *selectStmt) ForUpdate() Lock {
+	return st.lock("update")
+}
+
+func (st *selectStmt) ForShare() Lock {
+	return st.lock("share")
+}
+
+func (st *selectStmt) LockInShareMode() Lock {
+	return st.lock("lock in share mode")
+}
+
 func (st *selectStmt) make() *buffer {
 	var b buffer
 	b.push("select")
+	if !st.hints.empty() {
+		b.push(&st.hints)
+	}
 	if st.distinct != nil {
 		b.push("distinct")
 
@@ -274,6 +387,10 @@ func (st *selectStmt) make() *buffer {
 			b.push(&st.distinct.columns)
 		}
 	}
+	if !st.modifiers.empty() {
+		st.modifiers.sep = " "
+		b.push(&st.modifiers)
+	}
 	if !st.columns.empty() {
 		b.push(&st.columns)
 	}
@@ -289,11 +406,17 @@ func (st *selectStmt) make() *buffer {
 		b.push("where", &st.where)
 	}
 	if !st.groupBy.empty() {
-		b.push("group by", &st.groupBy)
+		b.push("group by", &st.groupBy.group)
+		if st.groupBy.rollup {
+			b.push("with rollup")
+		}
 	}
 	if !st.having.empty() {
 		b.push("having", &st.having)
 	}
+	if !st.windows.empty() {
+		b.push("window", &st.windows)
+	}
 	if !st.orderBy.empty() {
 		b.push("order by", &st.orderBy)
 	}
@@ -303,15 +426,21 @@ func (st *selectStmt) make() *buffer {
 	if st.offset != nil {
 		b.push("offset", *st.offset)
 	}
+	if !st.locks.empty() {
+		st.locks.sep = " "
+		b.push(&st.locks)
+	}
 
 	return &b
 }
 
 type join struct {
-	typ   string // join, inner join, full outer join, left join, right join
-	table builder
-	using group
-	on    cond
+	indexHints
+	typ     string // join, inner join, full outer join, left join, right join, straight_join
+	table   builder
+	derived bool // derived table or json_table, not allow index hints
+	using   group
+	on      cond
 }
 
 func (st *join) On(f func(b Cond)) {
@@ -325,6 +454,12 @@ func (st *join) Using(col ...string) {
 func (st *join) build() []interface{} {
 	var b buffer
 	b.push(st.typ, st.table)
+	if !st.hints.empty() {
+		if st.derived {
+			b.push(errorf("mystmt: index hints are not allowed on derived table"))
+		}
+		b.push(&st.hints)
+	}
 	if !st.using.empty() {
 		b.push("using")
 		b.push(&st.using)
@@ -336,7 +471,7 @@ func (st *join) build() []interface{} {
 }
 
 type orderBy struct {
-	col       string
+	col       interface{}
 	direction string
 	nulls     string
 }
@@ -373,6 +508,15 @@ func (st *orderBy) build() []interface{} {
 	return b.q
 }
 
+type groupBy struct {
+	group
+	rollup bool
+}
+
+func (st *groupBy) WithRollup() {
+	st.rollup = true
+}
+
 type values struct {
 	group
 }
@@ -0,0 +1,207 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestSubquery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"not in select",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("tenant_id", 1)
+					b.NotInSelect("id", func(b mystmt.SelectStatement) {
+						b.Columns("user_id")
+						b.From("bans")
+						b.Where(func(b mystmt.Cond) {
+							b.Eq("active", true)
+						})
+					})
+					b.Eq("status", "active")
+				})
+			}),
+			`
+				select * from users
+				where (tenant_id = ?
+					and id not in (select user_id from bans where (active = ?))
+					and status = ?)
+			`,
+			[]interface{}{1, true, "active"},
+		},
+		{
+			"exists",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users u")
+				b.Where(func(b mystmt.Cond) {
+					b.Exists(func(b mystmt.SelectStatement) {
+						b.Columns(1)
+						b.From("permissions p")
+						b.Where(func(b mystmt.Cond) {
+							b.EqRaw("p.user_id", "u.id")
+							b.Eq("p.name", "read")
+						})
+					})
+				})
+			}),
+			`
+				select * from users u
+				where (exists (select 1 from permissions p where (p.user_id = u.id and p.name = ?)))
+			`,
+			[]interface{}{"read"},
+		},
+		{
+			"not exists",
+			mystmt.Delete(func(b mystmt.DeleteStatement) {
+				b.From("orders o")
+				b.Where(func(b mystmt.Cond) {
+					b.NotExists(func(b mystmt.SelectStatement) {
+						b.Columns(1)
+						b.From("users u")
+						b.Where(func(b mystmt.Cond) {
+							b.EqRaw("u.id", "o.user_id")
+						})
+					})
+				})
+			}),
+			`
+				delete from orders o
+				where (not exists (select 1 from users u where (u.id = o.user_id)))
+			`,
+			nil,
+		},
+		{
+			"op select",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("products")
+				b.Where(func(b mystmt.Cond) {
+					b.OpSelect("price", ">", func(b mystmt.SelectStatement) {
+						b.Columns(mystmt.Func("avg", "price"))
+						b.From("products")
+						b.Where(func(b mystmt.Cond) {
+							b.Eq("category", "book")
+						})
+					})
+				})
+			}),
+			`
+				select * from products
+				where (price > (select avg(price) from products where (category = ?)))
+			`,
+			[]interface{}{"book"},
+		},
+		{
+			"any all select",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("t1")
+				b.Where(func(b mystmt.Cond) {
+					b.AnySelect("a", ">", func(b mystmt.SelectStatement) {
+						b.Columns("a")
+						b.From("t2")
+					})
+					b.AllSelect("b", "<>", func(b mystmt.SelectStatement) {
+						b.Columns("b")
+						b.From("t3")
+					})
+				})
+			}),
+			`
+				select * from t1
+				where (a > any (select a from t2)
+					and b <> all (select b from t3))
+			`,
+			nil,
+		},
+		{
+			"union subqueries",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("*")
+				b.From("users")
+				b.Where(func(b mystmt.Cond) {
+					b.InUnion("id", func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns("user_id")
+							b.From("admins")
+							b.Where(func(b mystmt.Cond) {
+								b.Eq("level", 1)
+							})
+						})
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns("user_id")
+							b.From("owners")
+							b.Where(func(b mystmt.Cond) {
+								b.Eq("level", 2)
+							})
+						})
+					})
+					b.NotInUnion("id", func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns("user_id")
+							b.From("bans")
+						})
+					})
+					b.ExistsUnion(func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns(1)
+						})
+					})
+					b.NotExistsUnion(func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns(2)
+						})
+					})
+					b.OpUnion("score", "=", func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns(3)
+						})
+					})
+					b.AnyUnion("a", "=", func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns(4)
+						})
+					})
+					b.AllUnion("b", ">", func(b mystmt.UnionStatement) {
+						b.Select(func(b mystmt.SelectStatement) {
+							b.Columns(5)
+						})
+					})
+				})
+			}),
+			`
+				select * from users
+				where (id in ((select user_id from admins where (level = ?)) union (select user_id from owners where (level = ?)))
+					and id not in ((select user_id from bans))
+					and exists ((select 1))
+					and not exists ((select 2))
+					and score = ((select 3))
+					and a = any ((select 4))
+					and b > all ((select 5)))
+			`,
+			[]interface{}{1, 2},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+		})
+	}
+}
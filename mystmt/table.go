@@ -0,0 +1,537 @@
+package mystmt
+
+// CreateTable builds create table statement
+func CreateTable(f func(b CreateTableStatement)) *Result {
+	var st createTableStmt
+	f(&st)
+	return newResult(build(st.make()))
+}
+
+// CreateTableStatement is the create table statement builder
+type CreateTableStatement interface {
+	Temporary()
+	IfNotExists()
+	Table(table string)
+	Column(name, typ string) Column
+	PrimaryKey(col ...string)
+	Unique(name string, col ...string)
+	Index(name string, col ...string)
+	FullText(name string, col ...string)
+	ForeignKey(name string, col ...string) ForeignKey
+	Engine(engine string)
+	CharacterSet(charset string)
+	Collate(collation string)
+	Comment(comment string)
+	PartitionByHash(expr string, n int)
+	PartitionByKey(n int, col ...string)
+	PartitionByRange(expr string, f func(b Partitions))
+	PartitionByRangeColumns(col []string, f func(b Partitions))
+	PartitionByList(expr string, f func(b Partitions))
+	PartitionByListColumns(col []string, f func(b Partitions))
+}
+
+// Column is the column definition builder,
+// default value renders as literal, use DefaultRaw for expression
+type Column interface {
+	NotNull() Column
+	Null() Column
+	Default(value interface{}) Column
+	DefaultRaw(expr string) Column
+	OnUpdate(expr string) Column
+	AutoIncrement() Column
+	Unique() Column
+	PrimaryKey() Column
+	Collate(collation string) Column
+	Comment(comment string) Column
+	Generated(expr string, stored bool) Column
+
+	// First and After are column positions for alter table
+	First() Column
+	After(col string) Column
+}
+
+// ForeignKey is the foreign key constraint builder
+type ForeignKey interface {
+	References(table string, col ...string) ForeignKey
+	OnDelete(action string) ForeignKey
+	OnUpdate(action string) ForeignKey
+}
+
+// Partitions is the range and list partitions builder,
+// values render as literal, use MaxValue for maxvalue
+type Partitions interface {
+	LessThan(name string, value ...interface{})
+	In(name string, value ...interface{})
+}
+
+// MaxValue is the maxvalue for range partition
+var MaxValue Expr = newExpr("maxvalue")
+
+type createTableStmt struct {
+	temporary   bool
+	ifNotExists bool
+	table       string
+	defs        group
+	options     group
+	partition   buffer
+}
+
+func (st *createTableStmt) Temporary() {
+	st.temporary = true
+}
+
+func (st *createTableStmt) IfNotExists() {
+	st.ifNotExists = true
+}
+
+func (st *createTableStmt) Table(table string) {
+	st.table = table
+}
+
+func (st *createTableStmt) Column(name, typ string) Column {
+	x := columnDef{name: name, typ: typ}
+	st.defs.push(&x)
+	return &x
+}
+
+func (st *createTableStmt) PrimaryKey(col ...string) {
+	st.defs.push(keyDef("primary key", "", col))
+}
+
+func (st *createTableStmt) Unique(name string, col ...string) {
+	st.defs.push(keyDef("unique key", name, col))
+}
+
+func (st *createTableStmt) Index(name string, col ...string) {
+	st.defs.push(keyDef("key", name, col))
+}
+
+func (st *createTableStmt) FullText(name string, col ...string) {
+	st.defs.push(keyDef("fulltext key", name, col))
+}
+
+func (st *createTableStmt) ForeignKey(name string, col ...string) ForeignKey {
+	x := foreignKey{name: name}
+	x.col.pushString(col...)
+	st.defs.push(&x)
+	return &x
+}
+
+func (st *createTableStmt) Engine(engine string) {
+	st.options.push("engine = " + engine)
+}
+
+func (st *createTableStmt) CharacterSet(charset string) {
+	st.options.push("default character set = " + charset)
+}
+
+func (st *createTableStmt) Collate(collation string) {
+	st.options.push("collate = " + collation)
+}
+
+func (st *createTableStmt) Comment(comment string) {
+	st.options.push(withGroup(" ", "comment =", notArg{comment}))
+}
+
+func (st *createTableStmt) PartitionByHash(expr string, n int) {
+	st.partition = buffer{}
+	st.partition.push("partition by hash", parenString(expr), "partitions", n)
+}
+
+func (st *createTableStmt) PartitionByKey(n int, col ...string) {
+	st.partition = buffer{}
+	st.partition.push("partition by key", parenString(col...), "partitions", n)
+}
+
+func (st *createTableStmt) PartitionByRange(expr string, f func(b Partitions)) {
+	st.partitionBy("range", parenString(expr), f)
+}
+
+func (st *createTableStmt) PartitionByRangeColumns(col []string, f func(b Partitions)) {
+	st.partitionBy("range columns", parenString(col...), f)
+}
+
+func (st *createTableStmt) PartitionByList(expr string, f func(b Partitions)) {
+	st.partitionBy("list", parenString(expr), f)
+}
+
+func (st *createTableStmt) PartitionByListColumns(col []string, f func(b Partitions)) {
+	st.partitionBy("list columns", parenString(col...), f)
+}
+
+func (st *createTableStmt) partitionBy(typ string, expr interface{}, f func(b Partitions)) {
+	var x partitions
+	f(&x)
+
+	st.partition = buffer{}
+	st.partition.push("partition by "+typ, expr, paren(&x.defs))
+}
+
+func (st *createTableStmt) make() *buffer {
+	var b buffer
+	b.push("create")
+	if st.temporary {
+		b.push("temporary")
+	}
+	b.push("table")
+	if st.ifNotExists {
+		b.push("if not exists")
+	}
+	b.push(st.table, paren(&st.defs))
+	if !st.options.empty() {
+		st.options.sep = " "
+		b.push(&st.options)
+	}
+	if !st.partition.empty() {
+		b.push(&st.partition)
+	}
+	return &b
+}
+
+type columnDef struct {
+	name          string
+	typ           string
+	collate       string
+	generated     interface{}
+	null          string
+	defaultValue  interface{}
+	onUpdate      string
+	autoIncrement bool
+	unique        bool
+	primaryKey    bool
+	comment       interface{}
+	position      interface{}
+}
+
+func (st *columnDef) NotNull() Column {
+	st.null = "not null"
+	return st
+}
+
+func (st *columnDef) Null() Column {
+	st.null = "null"
+	return st
+}
+
+func (st *columnDef) Default(value interface{}) Column {
+	st.defaultValue = notArg{value}
+	return st
+}
+
+func (st *columnDef) DefaultRaw(expr string) Column {
+	st.defaultValue = expr
+	return st
+}
+
+func (st *columnDef) OnUpdate(expr string) Column {
+	st.onUpdate = expr
+	return st
+}
+
+func (st *columnDef) AutoIncrement() Column {
+	st.autoIncrement = true
+	return st
+}
+
+func (st *columnDef) Unique() Column {
+	st.unique = true
+	return st
+}
+
+func (st *columnDef) PrimaryKey() Column {
+	st.primaryKey = true
+	return st
+}
+
+func (st *columnDef) Collate(collation string) Column {
+	st.collate = collation
+	return st
+}
+
+func (st *columnDef) Comment(comment string) Column {
+	st.comment = notArg{comment}
+	return st
+}
+
+// Generated makes column generated from expr, stored or virtual
+func (st *columnDef) Generated(expr string, stored bool) Column {
+	typ := "virtual"
+	if stored {
+		typ = "stored"
+	}
+	st.generated = withGroup(" ", "generated always as", parenString(expr), typ)
+	return st
+}
+
+func (st *columnDef) First() Column {
+	st.position = "first"
+	return st
+}
+
+func (st *columnDef) After(col string) Column {
+	st.position = withGroup(" ", "after", col)
+	return st
+}
+
+func (st *columnDef) build() []interface{} {
+	var b buffer
+	b.push(st.name, st.typ)
+	if st.collate != "" {
+		b.push("collate", st.collate)
+	}
+	if st.generated != nil {
+		b.push(st.generated)
+	}
+	if st.null != "" {
+		b.push(st.null)
+	}
+	if st.defaultValue != nil {
+		b.push("default", st.defaultValue)
+	}
+	if st.onUpdate != "" {
+		b.push("on update", st.onUpdate)
+	}
+	if st.autoIncrement {
+		b.push("auto_increment")
+	}
+	if st.unique {
+		b.push("unique")
+	}
+	if st.primaryKey {
+		b.push("primary key")
+	}
+	if st.comment != nil {
+		b.push("comment", st.comment)
+	}
+	if st.position != nil {
+		b.push(st.position)
+	}
+	return b.q
+}
+
+// keyDef builds key definition, ex. unique key name (a, b)
+func keyDef(typ, name string, col []string) interface{} {
+	var g group
+	g.sep = " "
+	g.push(typ)
+	if name != "" {
+		g.push(name)
+	}
+	g.push(parenString(col...))
+	return &g
+}
+
+type foreignKey struct {
+	name     string
+	col      group
+	refTable string
+	refCol   group
+	onDelete string
+	onUpdate string
+}
+
+func (st *foreignKey) References(table string, col ...string) ForeignKey {
+	st.refTable = table
+	st.refCol.pushString(col...)
+	return st
+}
+
+func (st *foreignKey) OnDelete(action string) ForeignKey {
+	st.onDelete = action
+	return st
+}
+
+func (st *foreignKey) OnUpdate(action string) ForeignKey {
+	st.onUpdate = action
+	return st
+}
+
+func (st *foreignKey) build() []interface{} {
+	var b buffer
+	if st.name != "" {
+		b.push("constraint", st.name)
+	}
+	b.push("foreign key", paren(&st.col), "references", st.refTable, paren(&st.refCol))
+	if st.onDelete != "" {
+		b.push("on delete", st.onDelete)
+	}
+	if st.onUpdate != "" {
+		b.push("on update", st.onUpdate)
+	}
+	return b.q
+}
+
+type partitions struct {
+	defs group
+}
+
+func (st *partitions) LessThan(name string, value ...interface{}) {
+	st.defs.push(withGroup(" ", "partition", name, "values less than", partitionValues(value)))
+}
+
+func (st *partitions) In(name string, value ...interface{}) {
+	st.defs.push(withGroup(" ", "partition", name, "values in", partitionValues(value)))
+}
+
+func partitionValues(value []interface{}) interface{} {
+	var p parenGroup
+	for _, v := range value {
+		if _, ok := v.(builder); ok {
+			p.push(v)
+			continue
+		}
+		p.push(notArg{v})
+	}
+	return &p
+}
+
+// AlterTable builds alter table statement
+func AlterTable(f func(b AlterTableStatement)) *Result {
+	var st alterTableStmt
+	f(&st)
+	return newResult(build(st.make()))
+}
+
+// AlterTableStatement is the alter table statement builder
+type AlterTableStatement interface {
+	Table(table string)
+	AddColumn(name, typ string) Column
+	ModifyColumn(name, typ string) Column
+	ChangeColumn(oldName, name, typ string) Column
+	RenameColumn(oldName, name string)
+	DropColumn(name string)
+	AddPrimaryKey(col ...string)
+	AddUnique(name string, col ...string)
+	AddIndex(name string, col ...string)
+	AddFullText(name string, col ...string)
+	AddForeignKey(name string, col ...string) ForeignKey
+	DropPrimaryKey()
+	DropIndex(name string)
+	DropForeignKey(name string)
+	RenameTo(table string)
+}
+
+type alterTableStmt struct {
+	table string
+	specs group
+}
+
+func (st *alterTableStmt) Table(table string) {
+	st.table = table
+}
+
+func (st *alterTableStmt) column(spec string, x *columnDef) Column {
+	st.specs.push(withGroup(" ", spec, x))
+	return x
+}
+
+func (st *alterTableStmt) AddColumn(name, typ string) Column {
+	return st.column("add column", &columnDef{name: name, typ: typ})
+}
+
+func (st *alterTableStmt) ModifyColumn(name, typ string) Column {
+	return st.column("modify column", &columnDef{name: name, typ: typ})
+}
+
+func (st *alterTableStmt) ChangeColumn(oldName, name, typ string) Column {
+	return st.column("change column "+oldName, &columnDef{name: name, typ: typ})
+}
+
+func (st *alterTableStmt) RenameColumn(oldName, name string) {
+	st.specs.push(withGroup(" ", "rename column", oldName, "to", name))
+}
+
+func (st *alterTableStmt) DropColumn(name string) {
+	st.specs.push(withGroup(" ", "drop column", name))
+}
+
+func (st *alterTableStmt) AddPrimaryKey(col ...string) {
+	st.specs.push(withGroup(" ", "add", keyDef("primary key", "", col)))
+}
+
+func (st *alterTableStmt) AddUnique(name string, col ...string) {
+	st.specs.push(withGroup(" ", "add", keyDef("unique key", name, col)))
+}
+
+func (st *alterTableStmt) AddIndex(name string, col ...string) {
+	st.specs.push(withGroup(" ", "add", keyDef("key", name, col)))
+}
+
+func (st *alterTableStmt) AddFullText(name string, col ...string) {
+	st.specs.push(withGroup(" ", "add", keyDef("fulltext key", name, col)))
+}
+
+func (st *alterTableStmt) AddForeignKey(name string, col ...string) ForeignKey {
+	x := foreignKey{name: name}
+	x.col.pushString(col...)
+	st.specs.push(withGroup(" ", "add", &x))
+	return &x
+}
+
+func (st *alterTableStmt) DropPrimaryKey() {
+	st.specs.push("drop primary key")
+}
+
+func (st *alterTableStmt) DropIndex(name string) {
+	st.specs.push(withGroup(" ", "drop index", name))
+}
+
+func (st *alterTableStmt) DropForeignKey(name string) {
+	st.specs.push(withGroup(" ", "drop foreign key", name))
+}
+
+func (st *alterTableStmt) RenameTo(table string) {
+	st.specs.push(withGroup(" ", "rename to", table))
+}
+
+func (st *alterTableStmt) make() *buffer {
+	var b buffer
+	b.push("alter table", st.table, &st.specs)
+	return &b
+}
+
+// DropTable builds drop table statement
+func DropTable(f func(b DropTableStatement)) *Result {
+	var st dropTableStmt
+	f(&st)
+	return newResult(build(st.make()))
+}
+
+// DropTableStatement is the drop table statement builder
+type DropTableStatement interface {
+	Temporary()
+	IfExists()
+	Table(table ...string)
+}
+
+type dropTableStmt struct {
+	temporary bool
+	ifExists  bool
+	tables    group
+}
+
+func (st *dropTableStmt) Temporary() {
+	st.temporary = true
+}
+
+func (st *dropTableStmt) IfExists() {
+	st.ifExists = true
+}
+
+func (st *dropTableStmt) Table(table ...string) {
+	st.tables.pushString(table...)
+}
+
+func (st *dropTableStmt) make() *buffer {
+	var b buffer
+	b.push("drop")
+	if st.temporary {
+		b.push("temporary")
+	}
+	b.push("table")
+	if st.ifExists {
+		b.push("if exists")
+	}
+	b.push(&st.tables)
+	return &b
+}
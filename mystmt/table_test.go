@@ -0,0 +1,221 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestTable(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+	}{
+		{
+			"create table",
+			mystmt.CreateTable(func(b mystmt.CreateTableStatement) {
+				b.IfNotExists()
+				b.Table("users")
+				b.Column("id", "bigint unsigned").NotNull().AutoIncrement()
+				b.Column("tenant_id", "bigint unsigned").NotNull()
+				b.Column("email", "varchar(255)").Collate("utf8mb4_bin").NotNull().Comment("login's email")
+				b.Column("status", "varchar(16)").NotNull().Default("active")
+				b.Column("data", "json").Null()
+				b.Column("name", "varchar(255)").Generated("data->>'$.name'", false)
+				b.Column("created_at", "timestamp(6)").NotNull().DefaultRaw("current_timestamp(6)")
+				b.Column("updated_at", "timestamp(6)").NotNull().DefaultRaw("current_timestamp(6)").OnUpdate("current_timestamp(6)")
+				b.PrimaryKey("id")
+				b.Unique("users_email_idx", "tenant_id", "email")
+				b.Index("users_created_at_idx", "created_at desc")
+				b.FullText("", "name")
+				b.ForeignKey("users_tenant_fk", "tenant_id").References("tenants", "id").OnDelete("cascade").OnUpdate("restrict")
+				b.Engine("InnoDB")
+				b.CharacterSet("utf8mb4")
+				b.Collate("utf8mb4_unicode_ci")
+				b.Comment("users")
+			}),
+			`
+				create table if not exists users (id bigint unsigned not null auto_increment,
+					tenant_id bigint unsigned not null,
+					email varchar(255) collate utf8mb4_bin not null comment 'login\'s email',
+					status varchar(16) not null default 'active',
+					data json null,
+					name varchar(255) generated always as (data->>'$.name') virtual,
+					created_at timestamp(6) not null default current_timestamp(6),
+					updated_at timestamp(6) not null default current_timestamp(6) on update current_timestamp(6),
+					primary key (id),
+					unique key users_email_idx (tenant_id, email),
+					key users_created_at_idx (created_at desc),
+					fulltext key (name),
+					constraint users_tenant_fk foreign key (tenant_id) references tenants (id) on delete cascade on update restrict)
+				engine = InnoDB default character set = utf8mb4 collate = utf8mb4_unicode_ci comment = 'users'
+			`,
+		},
+		{
+			"create temporary table",
+			mystmt.CreateTable(func(b mystmt.CreateTableStatement) {
+				b.Temporary()
+				b.Table("tmp")
+				b.Column("id", "int").PrimaryKey()
+				b.Column("total", "decimal(10, 2)").Generated("price * qty", true).Unique()
+			}),
+			`
+				create temporary table tmp (id int primary key,
+					total decimal(10, 2) generated always as (price * qty) stored unique)
+			`,
+		},
+		{
+			"partition by range",
+			mystmt.CreateTable(func(b mystmt.CreateTableStatement) {
+				b.Table("logs")
+				b.Column("id", "bigint").NotNull()
+				b.Column("created_at", "datetime").NotNull()
+				b.PrimaryKey("id", "created_at")
+				b.PartitionByRange("year(created_at)", func(b mystmt.Partitions) {
+					b.LessThan("p2020", 2021)
+					b.LessThan("p2021", 2022)
+					b.LessThan("pmax", mystmt.MaxValue)
+				})
+			}),
+			`
+				create table logs (id bigint not null,
+					created_at datetime not null,
+					primary key (id, created_at))
+				partition by range (year(created_at)) (partition p2020 values less than (2021),
+					partition p2021 values less than (2022),
+					partition pmax values less than (maxvalue))
+			`,
+		},
+		{
+			"partition by list columns",
+			mystmt.CreateTable(func(b mystmt.CreateTableStatement) {
+				b.Table("t")
+				b.Column("region", "varchar(8)")
+				b.PartitionByListColumns([]string{"region"}, func(b mystmt.Partitions) {
+					b.In("p_asia", "th", "jp")
+					b.In("p_eu", "de")
+				})
+			}),
+			`
+				create table t (region varchar(8))
+				partition by list columns (region) (partition p_asia values in ('th', 'jp'),
+					partition p_eu values in ('de'))
+			`,
+		},
+		{
+			"partition by hash key",
+			mystmt.CreateTable(func(b mystmt.CreateTableStatement) {
+				b.Table("t")
+				b.Column("id", "int")
+				b.PartitionByHash("id", 4)
+			}),
+			`
+				create table t (id int) partition by hash (id) partitions 4
+			`,
+		},
+		{
+			"alter table",
+			mystmt.AlterTable(func(b mystmt.AlterTableStatement) {
+				b.Table("users")
+				b.AddColumn("phone", "varchar(32)").Null().After("email")
+				b.AddColumn("seq", "int").NotNull().Default(0).First()
+				b.ModifyColumn("name", "varchar(512)").NotNull()
+				b.ChangeColumn("data", "doc", "json")
+				b.RenameColumn("status", "state")
+				b.DropColumn("legacy")
+				b.AddPrimaryKey("id")
+				b.AddUnique("users_phone_idx", "phone")
+				b.AddIndex("users_name_idx", "name(32)")
+				b.AddFullText("users_doc_ft", "name", "email")
+				b.AddForeignKey("users_org_fk", "org_id").References("orgs", "id").OnDelete("set null")
+				b.DropPrimaryKey()
+				b.DropIndex("users_old_idx")
+				b.DropForeignKey("users_old_fk")
+				b.RenameTo("members")
+			}),
+			`
+				alter table users
+					add column phone varchar(32) null after email,
+					add column seq int not null default 0 first,
+					modify column name varchar(512) not null,
+					change column data doc json,
+					rename column status to state,
+					drop column legacy,
+					add primary key (id),
+					add unique key users_phone_idx (phone),
+					add key users_name_idx (name(32)),
+					add fulltext key users_doc_ft (name, email),
+					add constraint users_org_fk foreign key (org_id) references orgs (id) on delete set null,
+					drop primary key,
+					drop index users_old_idx,
+					drop foreign key users_old_fk,
+					rename to members
+			`,
+		},
+		{
+			"drop table",
+			mystmt.DropTable(func(b mystmt.DropTableStatement) {
+				b.IfExists()
+				b.Table("users", "tenants")
+			}),
+			`
+				drop table if exists users, tenants
+			`,
+		},
+		{
+			"drop temporary table",
+			mystmt.DropTable(func(b mystmt.DropTableStatement) {
+				b.Temporary()
+				b.Table("tmp")
+			}),
+			`
+				drop temporary table tmp
+			`,
+		},
+		{
+			"create index",
+			mystmt.CreateIndex(func(b mystmt.CreateIndexStatement) {
+				b.Unique()
+				b.Name("users_email_idx")
+				b.On("users", "tenant_id", "email")
+			}),
+			`
+				create unique index users_email_idx on users (tenant_id, email)
+			`,
+		},
+		{
+			"create fulltext index",
+			mystmt.CreateIndex(func(b mystmt.CreateIndexStatement) {
+				b.FullText()
+				b.Name("posts_body_ft")
+				b.On("posts", "title", "body")
+			}),
+			`
+				create fulltext index posts_body_ft on posts (title, body)
+			`,
+		},
+		{
+			"drop index",
+			mystmt.DropIndex(func(b mystmt.DropIndexStatement) {
+				b.Name("users_email_idx")
+				b.On("users")
+			}),
+			`
+				drop index users_email_idx on users
+			`,
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.Empty(t, args)
+		})
+	}
+}
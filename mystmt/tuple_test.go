@@ -0,0 +1,75 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestTuple(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keyset pagination", func(t *testing.T) {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("posts")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("tenant_id", 1)
+				b.TupleOp([]string{"created_at", "id"}, "<", "2020-01-01", 10)
+			})
+			b.OrderBy("created_at").Desc()
+			b.OrderBy("id").Desc()
+			b.Limit(20)
+		}).SQL()
+
+		assert.Equal(t,
+			"select * from posts where (tenant_id = ? and (created_at, id) < (?, ?)) order by created_at desc, id desc limit 20",
+			q,
+		)
+		assert.EqualValues(t, []interface{}{1, "2020-01-01", 10}, args)
+	})
+
+	t.Run("in", func(t *testing.T) {
+		q, args := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns("*")
+			b.From("members")
+			b.Where(func(b mystmt.Cond) {
+				b.TupleIn([]string{"org_id", "user_id"},
+					[]interface{}{1, 2},
+					[]interface{}{3, 4},
+				)
+			})
+		}).SQL()
+
+		assert.Equal(t,
+			"select * from members where ((org_id, user_id) in ((?, ?), (?, ?)))",
+			q,
+		)
+		assert.EqualValues(t, []interface{}{1, 2, 3, 4}, args)
+	})
+
+	t.Run("arity", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Where(func(b mystmt.Cond) {
+				b.TupleOp([]string{"a", "b"}, "=", 1)
+			})
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Where(func(b mystmt.Cond) {
+				b.TupleIn([]string{"a", "b"}, []interface{}{1, 2}, []interface{}{3})
+			})
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Where(func(b mystmt.Cond) {
+				b.TupleOp(nil, "=")
+			})
+		})
+		assert.Error(t, r.Err())
+	})
+}
@@ -1,59 +1,117 @@
 package mystmt
 
+import (
+	"math"
+)
+
+// Union builds union statement
 func Union(f func(b UnionStatement)) *Result {
-	var st unionStmt
-	f(&st)
-	return newResult(build(st.make()))
+	return NewUnion(f).Build()
+}
+
+// UnionBuilder is the reusable union statement builder, see Builder
+type UnionBuilder = Builder[UnionStatement]
+
+// NewUnion creates new union statement builder
+func NewUnion(f ...func(b UnionStatement)) *UnionBuilder {
+	return newBuilder(func() (UnionStatement, statement) {
+		var st unionStmt
+		return &st, &st
+	}, f)
 }
 
 type UnionStatement interface {
 	Select(f func(b SelectStatement))
 	AllSelect(f func(b SelectStatement))
 	DistinctSelect(f func(b SelectStatement))
-	OrderBy(col string) OrderBy
+	Intersect(f func(b SelectStatement))
+	IntersectAll(f func(b SelectStatement))
+	IntersectDistinct(f func(b SelectStatement))
+	Except(f func(b SelectStatement))
+	ExceptAll(f func(b SelectStatement))
+	ExceptDistinct(f func(b SelectStatement))
+	Group(op SetOperator, f func(b UnionStatement))
+	OrderBy(col interface{}) OrderBy
 	Limit(n int64)
+	Offset(n int64)
 }
 
+// SetOperator is the set operation operator
+type SetOperator string
+
+// Set operators, intersect and except require MySQL 8.0.31 or MariaDB 10.3
+// (intersect all and except all require MariaDB 10.5)
+const (
+	SetUnion             SetOperator = "union"
+	SetUnionAll          SetOperator = "union all"
+	SetUnionDistinct     SetOperator = "union distinct"
+	SetIntersect         SetOperator = "intersect"
+	SetIntersectAll      SetOperator = "intersect all"
+	SetIntersectDistinct SetOperator = "intersect distinct"
+	SetExcept            SetOperator = "except"
+	SetExceptAll         SetOperator = "except all"
+	SetExceptDistinct    SetOperator = "except distinct"
+)
+
 type unionStmt struct {
 	b       buffer
 	orderBy group
 	limit   *int64
+	offset  *int64
 }
 
-func (st *unionStmt) Select(f func(b SelectStatement)) {
-	var x selectStmt
-	f(&x)
-
+// push pushes operand, operator is omitted for the first operand
+func (st *unionStmt) push(op SetOperator, x *buffer) {
 	if st.b.empty() {
-		st.b.push(paren(x.make()))
+		st.b.push(paren(x))
 	} else {
-		st.b.push("union", paren(x.make()))
+		st.b.push(string(op), paren(x))
 	}
 }
 
-func (st *unionStmt) AllSelect(f func(b SelectStatement)) {
-	var x selectStmt
-	f(&x)
+func (st *unionStmt) Select(f func(b SelectStatement)) {
+	st.push(SetUnion, subSelect(f))
+}
 
-	if st.b.empty() {
-		st.b.push(paren(x.make()))
-	} else {
-		st.b.push("union all", paren(x.make()))
-	}
+func (st *unionStmt) AllSelect(f func(b SelectStatement)) {
+	st.push(SetUnionAll, subSelect(f))
 }
 
 func (st *unionStmt) DistinctSelect(f func(b SelectStatement)) {
-	var x selectStmt
-	f(&x)
+	st.push(SetUnionDistinct, subSelect(f))
+}
 
-	if st.b.empty() {
-		st.b.push(paren(x.make()))
-	} else {
-		st.b.push("union distinct", paren(x.make()))
-	}
+func (st *unionStmt) Intersect(f func(b SelectStatement)) {
+	st.push(SetIntersect, subSelect(f))
 }
 
-func (st *unionStmt) OrderBy(col string) OrderBy {
+func (st *unionStmt) IntersectAll(f func(b SelectStatement)) {
+	st.push(SetIntersectAll, subSelect(f))
+}
+
+func (st *unionStmt) IntersectDistinct(f func(b SelectStatement)) {
+	st.push(SetIntersectDistinct, subSelect(f))
+}
+
+func (st *unionStmt) Except(f func(b SelectStatement)) {
+	st.push(SetExcept, subSelect(f))
+}
+
+func (st *unionStmt) ExceptAll(f func(b SelectStatement)) {
+	st.push(SetExceptAll, subSelect(f))
+}
+
+func (st *unionStmt) ExceptDistinct(f func(b SelectStatement)) {
+	st.push(SetExceptDistinct, subSelect(f))
+}
+
+// Group adds nested set operation as operand,
+// op is omitted for the first operand
+func (st *unionStmt) Group(op SetOperator, f func(b UnionStatement)) {
+	st.push(op, subUnion(f))
+}
+
+func (st *unionStmt) OrderBy(col interface{}) OrderBy {
 	p := orderBy{
 		col: col,
 	}
@@ -65,6 +123,10 @@ func (st *unionStmt) Limit(n int64) {
 	st.limit = &n
 }
 
+func (st *unionStmt) Offset(n int64) {
+	st.offset = &n
+}
+
 func (st *unionStmt) make() *buffer {
 	var b buffer
 	b.push(&st.b)
@@ -73,6 +135,12 @@ func (st *unionStmt) make() *buffer {
 	}
 	if st.limit != nil {
 		b.push("limit", *st.limit)
+	} else if st.offset != nil {
+		// offset requires limit, use max row count
+		b.push("limit", uint64(math.MaxUint64))
+	}
+	if st.offset != nil {
+		b.push("offset", *st.offset)
 	}
 	return &b
 }
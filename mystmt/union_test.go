@@ -44,6 +44,117 @@ func TestUnion(t *testing.T) {
 			`,
 			nil,
 		},
+		{
+			"intersect except",
+			mystmt.Union(func(b mystmt.UnionStatement) {
+				b.Select(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table1")
+				})
+				b.Intersect(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table2")
+				})
+				b.IntersectAll(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table3")
+				})
+				b.IntersectDistinct(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table4")
+				})
+				b.Except(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table5")
+				})
+				b.ExceptAll(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table6")
+				})
+				b.ExceptDistinct(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table7")
+				})
+				b.OrderBy("id")
+				b.Limit(10)
+				b.Offset(20)
+			}),
+			`
+				(select id from table1)
+				intersect (select id from table2)
+				intersect all (select id from table3)
+				intersect distinct (select id from table4)
+				except (select id from table5)
+				except all (select id from table6)
+				except distinct (select id from table7)
+				order by id
+				limit 10
+				offset 20
+			`,
+			nil,
+		},
+		{
+			"nested",
+			mystmt.Union(func(b mystmt.UnionStatement) {
+				b.Group(mystmt.SetUnion, func(b mystmt.UnionStatement) {
+					b.Select(func(b mystmt.SelectStatement) {
+						b.Columns("id")
+						b.From("table1")
+						b.Where(func(b mystmt.Cond) {
+							b.Eq("a", 1)
+						})
+					})
+					b.AllSelect(func(b mystmt.SelectStatement) {
+						b.Columns("id")
+						b.From("table2")
+					})
+				})
+				b.Except(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table3")
+					b.Where(func(b mystmt.Cond) {
+						b.Eq("b", 2)
+					})
+				})
+				b.Group(mystmt.SetIntersect, func(b mystmt.UnionStatement) {
+					b.Select(func(b mystmt.SelectStatement) {
+						b.Columns("id")
+						b.From("table4")
+					})
+					b.DistinctSelect(func(b mystmt.SelectStatement) {
+						b.Columns("id")
+						b.From("table5")
+						b.Where(func(b mystmt.Cond) {
+							b.Eq("c", 3)
+						})
+					})
+					b.OrderBy("id")
+					b.Limit(5)
+				})
+			}),
+			`
+				((select id from table1 where (a = ?)) union all (select id from table2))
+				except (select id from table3 where (b = ?))
+				intersect ((select id from table4) union distinct (select id from table5 where (c = ?)) order by id limit 5)
+			`,
+			[]interface{}{1, 2, 3},
+		},
+		{
+			"offset without limit",
+			mystmt.Union(func(b mystmt.UnionStatement) {
+				b.Select(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table1")
+				})
+				b.AllSelect(func(b mystmt.SelectStatement) {
+					b.Columns("id")
+					b.From("table2")
+				})
+				b.Offset(20)
+			}),
+			"(select id from table1) union all (select id from table2) limit 18446744073709551615 offset 20",
+			nil,
+		},
 	}
 
 	for _, tC := range cases {
@@ -2,12 +2,24 @@ package mystmt
 
 // Update builds update statement
 func Update(f func(b UpdateStatement)) *Result {
-	var st updateStmt
-	f(&st)
-	return newResult(build(st.make()))
+	return NewUpdate(f).Build()
 }
 
+// UpdateBuilder is the reusable update statement builder, see Builder
+type UpdateBuilder = Builder[UpdateStatement]
+
+// NewUpdate creates new update statement builder
+func NewUpdate(f ...func(b UpdateStatement)) *UpdateBuilder {
+	return newBuilder(func() (UpdateStatement, statement) {
+		var st updateStmt
+		return &st, &st
+	}, f)
+}
+
+// UpdateStatement is the update statement builder,
+// ORDER BY and LIMIT are not allowed on multiple-table update (From or Join)
 type UpdateStatement interface {
+	OptimizerHint(hint ...string)
 	Table(table string)
 	Set(col ...string) Set
 	From(table ...string)
@@ -18,21 +30,32 @@ type UpdateStatement interface {
 	RightJoin(table string) Join
 	Where(f func(b Cond))
 	WhereCurrentOf(cursor string)
+	OrderBy(col interface{}) OrderBy
+	Limit(n int64)
 }
 
 type Set interface {
 	To(value ...interface{})
 	ToRaw(rawValue ...interface{})
 	Select(f func(b SelectStatement))
+	JSONSet(path string, value interface{}) Set
+	JSONRemove(path ...string) Set
 }
 
 type updateStmt struct {
+	hints          optimizerHints
 	table          string
 	sets           group
 	from           group
 	joins          buffer
 	where          cond
 	whereCurrentOf string
+	orderBy        group
+	limit          *int64
+}
+
+func (st *updateStmt) OptimizerHint(hint ...string) {
+	st.hints.push(hint...)
 }
 
 func (st *updateStmt) Table(table string) {
@@ -89,9 +112,27 @@ func (st *updateStmt) WhereCurrentOf(cursor string) {
 	st.whereCurrentOf = cursor
 }
 
+func (st *updateStmt) OrderBy(col interface{}) OrderBy {
+	p := orderBy{
+		col: col,
+	}
+	st.orderBy.push(&p)
+	return &p
+}
+
+func (st *updateStmt) Limit(n int64) {
+	st.limit = &n
+}
+
 func (st *updateStmt) make() *buffer {
 	var b buffer
+	if (!st.from.empty() || !st.joins.empty()) && (!st.orderBy.empty() || st.limit != nil) {
+		b.push(errorf("mystmt: order by and limit are not allowed on multiple-table update"))
+	}
 	b.push("update")
+	if !st.hints.empty() {
+		b.push(&st.hints)
+	}
 	if st.table != "" {
 		b.push(st.table)
 	}
@@ -110,12 +151,19 @@ func (st *updateStmt) make() *buffer {
 	if st.whereCurrentOf != "" {
 		b.push("where current of", st.whereCurrentOf)
 	}
+	if !st.orderBy.empty() {
+		b.push("order by", &st.orderBy)
+	}
+	if st.limit != nil {
+		b.push("limit", *st.limit)
+	}
 	return &b
 }
 
 type set struct {
-	col group
-	to  group
+	col  group
+	to   group
+	json *parenGroup
 }
 
 func (st *set) To(value ...interface{}) {
@@ -134,6 +182,46 @@ func (st *set) Select(f func(b SelectStatement)) {
 	st.to.push(paren(x.make()))
 }
 
+// JSONSet sets value at path in json document column,
+// consecutive calls merge into single json_set
+func (st *set) JSONSet(path string, value interface{}) Set {
+	st.jsonFunc("json_set").push(jsonPath(path), Arg(value))
+	return st
+}
+
+// JSONRemove removes paths from json document column
+func (st *set) JSONRemove(path ...string) Set {
+	p := st.jsonFunc("json_remove")
+	for _, x := range path {
+		p.push(jsonPath(x))
+	}
+	return st
+}
+
+// jsonFunc returns json function to push arguments,
+// function wraps previous json function or column
+func (st *set) jsonFunc(name string) *parenGroup {
+	if len(st.col.q) != 1 {
+		st.to.push(errorf("mystmt: json functions require single column"))
+		return &parenGroup{}
+	}
+	if st.json != nil && st.json.prefix == name {
+		return st.json
+	}
+
+	var p parenGroup
+	p.prefix = name
+	if st.json != nil {
+		p.push(st.json)
+	} else {
+		p.push(st.col.q[0])
+	}
+	st.json = &p
+	st.to = group{}
+	st.to.push(&p)
+	return &p
+}
+
 func (st *set) build() []interface{} {
 	var b buffer
 	if len(st.col.q) > 1 {
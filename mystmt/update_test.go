@@ -15,7 +15,7 @@ func TestUpdate(t *testing.T) {
 		q, args := mystmt.Update(func(b mystmt.UpdateStatement) {
 			b.Table("users")
 			b.Set("name").To("test")
-			b.Set("email", "address", "updated_at").To("test@localhost", "123", mystmt.NotArg("now()"))
+			b.Set("email", "address", "updated_at").To("test@localhost", "123", mystmt.Func("now"))
 			b.Set("age").ToRaw(1)
 			b.Where(func(b mystmt.Cond) {
 				b.Eq("id", 5)
@@ -113,4 +113,45 @@ func TestUpdate(t *testing.T) {
 			args,
 		)
 	})
+
+	t.Run("update order by limit", func(t *testing.T) {
+		q, args := mystmt.Update(func(b mystmt.UpdateStatement) {
+			b.Table("jobs")
+			b.Set("status").To("expired")
+			b.Where(func(b mystmt.Cond) {
+				b.Eq("status", "pending")
+			})
+			b.OrderBy("id").Asc()
+			b.Limit(500)
+		}).SQL()
+
+		assert.Equal(t,
+			"update jobs set status = ? where (status = ?) order by id asc limit 500",
+			q,
+		)
+		assert.EqualValues(t,
+			[]interface{}{"expired", "pending"},
+			args,
+		)
+	})
+
+	t.Run("update join order by limit", func(t *testing.T) {
+		r := mystmt.Update(func(b mystmt.UpdateStatement) {
+			b.Table("t1")
+			b.Set("a").To(1)
+			b.Join("t2").On(func(b mystmt.Cond) {
+				b.EqRaw("t1.id", "t2.id")
+			})
+			b.Limit(10)
+		})
+		assert.Error(t, r.Err())
+
+		r = mystmt.Update(func(b mystmt.UpdateStatement) {
+			b.Table("t1")
+			b.Set("a").To(1)
+			b.Join("t2").Using("id")
+			b.OrderBy("t1.id")
+		})
+		assert.Error(t, r.Err())
+	})
 }
@@ -0,0 +1,160 @@
+package mystmt
+
+// Over builds window function call over window specification
+func Over(fn interface{}, f func(b Window)) interface{} {
+	var w window
+	f(&w)
+
+	var b buffer
+	b.push(fn, "over", w.make())
+	return &b
+}
+
+// OverWindow builds window function call over named window
+func OverWindow(fn interface{}, name string) interface{} {
+	var b buffer
+	b.push(fn, "over", name)
+	return &b
+}
+
+// Window is the window specification builder
+type Window interface {
+	Base(name string)
+	PartitionBy(col ...interface{})
+	OrderBy(col interface{}) OrderBy
+	Rows() Frame
+	Range() Frame
+}
+
+// Frame is the window frame builder, frame requires Start or Between
+type Frame interface {
+	Start(start FrameBound)
+	Between(start, end FrameBound)
+}
+
+// FrameBound is the window frame boundary
+type FrameBound interface {
+	builder
+}
+
+var (
+	UnboundedPreceding FrameBound = &frameBound{q: []interface{}{"unbounded preceding"}}
+	UnboundedFollowing FrameBound = &frameBound{q: []interface{}{"unbounded following"}}
+	CurrentRow         FrameBound = &frameBound{q: []interface{}{"current row"}}
+)
+
+// Preceding builds "n preceding" frame boundary,
+// n is not an argument, use Arg to mark as argument
+func Preceding(n interface{}) FrameBound {
+	return &frameBound{q: []interface{}{n, "preceding"}}
+}
+
+// Following builds "n following" frame boundary,
+// n is not an argument, use Arg to mark as argument
+func Following(n interface{}) FrameBound {
+	return &frameBound{q: []interface{}{n, "following"}}
+}
+
+type frameBound struct {
+	q []interface{}
+}
+
+func (st *frameBound) build() []interface{} {
+	return st.q
+}
+
+type window struct {
+	base        string
+	partitionBy group
+	orderBy     group
+	frame       *frame
+}
+
+func (st *window) Base(name string) {
+	st.base = name
+}
+
+func (st *window) PartitionBy(col ...interface{}) {
+	st.partitionBy.push(col...)
+}
+
+func (st *window) OrderBy(col interface{}) OrderBy {
+	p := orderBy{
+		col: col,
+	}
+	st.orderBy.push(&p)
+	return &p
+}
+
+func (st *window) Rows() Frame {
+	st.frame = &frame{typ: "rows"}
+	return st.frame
+}
+
+func (st *window) Range() Frame {
+	st.frame = &frame{typ: "range"}
+	return st.frame
+}
+
+func (st *window) empty() bool {
+	return st.base == "" && st.partitionBy.empty() && st.orderBy.empty() && st.frame == nil
+}
+
+func (st *window) make() interface{} {
+	if st.empty() {
+		return "()"
+	}
+
+	var b buffer
+	if st.base != "" {
+		b.push(st.base)
+	}
+	if !st.partitionBy.empty() {
+		b.push("partition by", &st.partitionBy)
+	}
+	if !st.orderBy.empty() {
+		b.push("order by", &st.orderBy)
+	}
+	if st.frame != nil {
+		b.push(st.frame)
+	}
+	return withParen(" ", &b)
+}
+
+type frame struct {
+	typ   string // rows, range
+	start FrameBound
+	end   FrameBound
+}
+
+func (st *frame) Start(start FrameBound) {
+	st.start = start
+	st.end = nil
+}
+
+func (st *frame) Between(start, end FrameBound) {
+	st.start = start
+	st.end = end
+}
+
+func (st *frame) build() []interface{} {
+	var b buffer
+	b.push(st.typ)
+	if st.end != nil {
+		b.push("between", st.start, "and", st.end)
+	} else if st.start != nil {
+		b.push(st.start)
+	} else {
+		b.push(errorf("mystmt: window frame %s requires start bound", st.typ))
+	}
+	return b.q
+}
+
+type namedWindow struct {
+	name   string
+	window window
+}
+
+func (st *namedWindow) build() []interface{} {
+	return []interface{}{st.name, "as", st.window.make()}
+}
@@ -0,0 +1,167 @@
+package mystmt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql/mystmt"
+)
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		result *mystmt.Result
+		query  string
+		args   []interface{}
+	}{
+		{
+			"over empty window",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("id", mystmt.As(mystmt.Over("row_number()", func(b mystmt.Window) {}), "rn"))
+				b.From("users")
+			}),
+			"select id, row_number() over () rn from users",
+			nil,
+		},
+		{
+			"over partition order",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(
+					"dept",
+					mystmt.Over(mystmt.Func("rank"), func(b mystmt.Window) {
+						b.PartitionBy("dept")
+						b.OrderBy("salary").Desc()
+					}),
+				)
+				b.From("employees")
+			}),
+			"select dept, rank() over (partition by dept order by salary desc) from employees",
+			nil,
+		},
+		{
+			"lag with argument",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.Over(mystmt.Func("lag", "salary", mystmt.Arg(1), mystmt.Arg(0)), func(b mystmt.Window) {
+					b.OrderBy("id")
+				}))
+				b.From("employees")
+				b.Where(func(b mystmt.Cond) {
+					b.Eq("dept", "it")
+				})
+			}),
+			"select lag(salary, ?, ?) over (order by id) from employees where (dept = ?)",
+			[]interface{}{1, 0, "it"},
+		},
+		{
+			"running sum with frame",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.Over("sum(amount)", func(b mystmt.Window) {
+					b.PartitionBy("account_id")
+					b.OrderBy("created_at")
+					b.Rows().Between(mystmt.UnboundedPreceding, mystmt.CurrentRow)
+				}))
+				b.From("transactions")
+			}),
+			`
+				select sum(amount) over (partition by account_id
+										 order by created_at
+										 rows between unbounded preceding and current row)
+				from transactions
+			`,
+			nil,
+		},
+		{
+			"range frame start",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.Over("avg(amount)", func(b mystmt.Window) {
+					b.OrderBy("id")
+					b.Range().Start(mystmt.Preceding(mystmt.Arg(5)))
+				}))
+				b.From("transactions")
+			}),
+			"select avg(amount) over (order by id range ? preceding) from transactions",
+			[]interface{}{5},
+		},
+		{
+			"rows between preceding and following",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(mystmt.Over("avg(amount)", func(b mystmt.Window) {
+					b.OrderBy("id")
+					b.Rows().Between(mystmt.Preceding(1), mystmt.Following(1))
+				}))
+				b.From("transactions")
+			}),
+			"select avg(amount) over (order by id rows between 1 preceding and 1 following) from transactions",
+			nil,
+		},
+		{
+			"named window",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns(
+					"val",
+					mystmt.As(mystmt.OverWindow("row_number()", "w"), "rn"),
+					mystmt.As(mystmt.Over("sum(val)", func(b mystmt.Window) {
+						b.Base("w")
+						b.Rows().Start(mystmt.UnboundedPreceding)
+					}), "total"),
+				)
+				b.From("numbers")
+				b.Window("w", func(b mystmt.Window) {
+					b.PartitionBy("grp")
+					b.OrderBy("val")
+				})
+				b.Window("w2", func(b mystmt.Window) {})
+				b.OrderBy(mystmt.OverWindow("row_number()", "w"))
+			}),
+			`
+				select val,
+					row_number() over w rn,
+					sum(val) over (w rows unbounded preceding) total
+				from numbers
+				window w as (partition by grp order by val), w2 as ()
+				order by row_number() over w
+			`,
+			nil,
+		},
+		{
+			"window after having",
+			mystmt.Select(func(b mystmt.SelectStatement) {
+				b.Columns("grp", mystmt.OverWindow("rank()", "w"))
+				b.From("numbers")
+				b.GroupBy("grp")
+				b.Having(func(b mystmt.Cond) {
+					b.Gt("count(*)", 1)
+				})
+				b.Window("w", func(b mystmt.Window) {
+					b.OrderBy("grp")
+				})
+				b.Limit(10)
+			}),
+			"select grp, rank() over w from numbers group by grp having (count(*) > ?) window w as (order by grp) limit 10",
+			[]interface{}{1},
+		},
+	}
+
+	for _, tC := range cases {
+		t.Run(tC.name, func(t *testing.T) {
+			q, args := tC.result.SQL()
+			assert.Equal(t, stripSpace(tC.query), q)
+			assert.EqualValues(t, tC.args, args)
+			assert.NoError(t, tC.result.Err())
+		})
+	}
+
+	t.Run("frame without bound", func(t *testing.T) {
+		r := mystmt.Select(func(b mystmt.SelectStatement) {
+			b.Columns(mystmt.Over("sum(amount)", func(b mystmt.Window) {
+				b.OrderBy("id")
+				b.Rows()
+			}))
+			b.From("payments")
+		})
+		assert.Error(t, r.Err())
+	})
+}
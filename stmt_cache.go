@@ -0,0 +1,323 @@
+package mysql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	dmysql "github.com/go-sql-driver/mysql"
+)
+
+// Preparer interface
+type Preparer interface {
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+}
+
+// StmtCache is the LRU cache of prepared statements keyed by query.
+//
+// Each statement can be prepared on every connection in the pool,
+// size * max open connections should less than server's max_prepared_stmt_count.
+// When server reaches max_prepared_stmt_count, StmtCache shrinks
+// and runs query without prepared statement.
+//
+// Inside tx, only cached statements are used by default,
+// set PrepareInTx to also prepare and cache query inside tx.
+type StmtCache struct {
+	// PrepareInTx prepares not cached query on db when running inside tx,
+	// then binds the statement to tx with tx.StmtContext.
+	// Prepare needs another connection from the pool while tx holds its connection,
+	// do not enable when pool allows only one connection (ex. SetMaxOpenConns(1)),
+	// it will deadlock. Set before use.
+	PrepareInTx bool
+
+	db    Preparer
+	size  int
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type stmtCacheItem struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+const (
+	defaultStmtCacheSize = 128
+)
+
+// NewStmtCache creates new statement cache,
+// size <= 0 will use default size
+func NewStmtCache(db Preparer, size int) *StmtCache {
+	if size <= 0 {
+		size = defaultStmtCacheSize
+	}
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// Len returns number of cached statements
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// acquire returns cached prepared statement for query,
+// statement will not be closed until released
+func (c *StmtCache) acquire(ctx context.Context, query string) (*stmtCacheItem, error) {
+	if it := c.acquireCached(query); it != nil {
+		return it, nil
+	}
+
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		if isMaxPreparedStmt(err) {
+			c.shrink()
+		}
+		return nil, err
+	}
+
+	var evicted []*sql.Stmt
+	c.mu.Lock()
+	if e, ok := c.items[query]; ok {
+		// other goroutine prepared the same query
+		c.ll.MoveToFront(e)
+		it := e.Value.(*stmtCacheItem)
+		it.refs++
+		c.mu.Unlock()
+		stmt.Close()
+		return it, nil
+	}
+	it := &stmtCacheItem{query: query, stmt: stmt, refs: 1}
+	c.items[query] = c.ll.PushFront(it)
+	for c.ll.Len() > c.size {
+		if s := c.remove(c.ll.Back()); s != nil {
+			evicted = append(evicted, s)
+		}
+	}
+	c.mu.Unlock()
+
+	closeStmts(evicted)
+	return it, nil
+}
+
+// acquireCached returns cached prepared statement for query without prepare,
+// or nil when query is not cached
+func (c *StmtCache) acquireCached(query string) *stmtCacheItem {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[query]
+	if !ok {
+		return nil
+	}
+	c.ll.MoveToFront(e)
+	it := e.Value.(*stmtCacheItem)
+	it.refs++
+	return it
+}
+
+func (c *StmtCache) release(it *stmtCacheItem) {
+	c.mu.Lock()
+	it.refs--
+	closeStmt := it.evicted && it.refs == 0
+	c.mu.Unlock()
+
+	if closeStmt {
+		it.stmt.Close()
+	}
+}
+
+// Close closes all cached statements,
+// statements in use will be closed after used
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	var stmts []*sql.Stmt
+	for c.ll.Len() > 0 {
+		if s := c.remove(c.ll.Back()); s != nil {
+			stmts = append(stmts, s)
+		}
+	}
+	c.mu.Unlock()
+
+	return closeStmts(stmts)
+}
+
+// shrink evicts half of cached statements
+func (c *StmtCache) shrink() {
+	c.mu.Lock()
+	var stmts []*sql.Stmt
+	for n := c.ll.Len() / 2; n > 0; n-- {
+		if s := c.remove(c.ll.Back()); s != nil {
+			stmts = append(stmts, s)
+		}
+	}
+	c.mu.Unlock()
+
+	closeStmts(stmts)
+}
+
+// evict removes statement of query from cache
+func (c *StmtCache) evict(query string) {
+	c.mu.Lock()
+	var s *sql.Stmt
+	if e, ok := c.items[query]; ok {
+		s = c.remove(e)
+	}
+	c.mu.Unlock()
+
+	if s != nil {
+		s.Close()
+	}
+}
+
+// remove removes element from cache, and returns statement to close
+// if statement is not in use, must hold lock
+func (c *StmtCache) remove(e *list.Element) *sql.Stmt {
+	c.ll.Remove(e)
+	it := e.Value.(*stmtCacheItem)
+	delete(c.items, it.query)
+	it.evicted = true
+	if it.refs > 0 {
+		return nil
+	}
+	return it.stmt
+}
+
+func closeStmts(stmts []*sql.Stmt) error {
+	var err error
+	for _, s := range stmts {
+		if e := s.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// QueryRowContext calls QueryRowContext on cached statement
+func (c *StmtCache) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return c.queryRow(ctx, nil, query, args...)
+}
+
+// QueryContext calls QueryContext on cached statement
+func (c *StmtCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return c.query(ctx, nil, query, args...)
+}
+
+// ExecContext calls ExecContext on cached statement
+func (c *StmtCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.exec(ctx, nil, query, args...)
+}
+
+// Tx returns statement cache that runs cached statements inside tx.
+//
+// Query that is not cached runs directly on tx without prepare,
+// unless PrepareInTx is set
+func (c *StmtCache) Tx(tx *sql.Tx) *TxStmtCache {
+	return &TxStmtCache{c, tx}
+}
+
+// stmt returns prepared statement for query and release function,
+// or nil when statement can not prepare.
+// Inside tx, only cached statement is used unless PrepareInTx is set
+func (c *StmtCache) stmt(ctx context.Context, tx *sql.Tx, query string) (*sql.Stmt, func()) {
+	if tx != nil {
+		it := c.acquireCached(query)
+		if it == nil && c.PrepareInTx {
+			it, _ = c.acquire(ctx, query)
+		}
+		if it == nil {
+			return nil, nil
+		}
+		// tx stmt will be closed when tx committed or rollback
+		return tx.StmtContext(ctx, it.stmt), func() { c.release(it) }
+	}
+
+	it, err := c.acquire(ctx, query)
+	if err != nil {
+		return nil, nil
+	}
+	return it.stmt, func() { c.release(it) }
+}
+
+func (c *StmtCache) queryer(tx *sql.Tx) Preparer {
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
+
+func (c *StmtCache) queryRow(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) *sql.Row {
+	// *sql.Row can not check max_prepared_stmt_count error,
+	// cache will shrink when prepare on next call
+	if stmt, release := c.stmt(ctx, tx, query); stmt != nil {
+		defer release()
+		return stmt.QueryRowContext(ctx, args...)
+	}
+	return c.queryer(tx).QueryRowContext(ctx, query, args...)
+}
+
+func (c *StmtCache) query(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (*sql.Rows, error) {
+	if stmt, release := c.stmt(ctx, tx, query); stmt != nil {
+		rows, err := stmt.QueryContext(ctx, args...)
+		release()
+		if !isMaxPreparedStmt(err) {
+			return rows, err
+		}
+		c.evict(query)
+		c.shrink()
+	}
+	return c.queryer(tx).QueryContext(ctx, query, args...)
+}
+
+func (c *StmtCache) exec(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (sql.Result, error) {
+	if stmt, release := c.stmt(ctx, tx, query); stmt != nil {
+		res, err := stmt.ExecContext(ctx, args...)
+		release()
+		if !isMaxPreparedStmt(err) {
+			return res, err
+		}
+		c.evict(query)
+		c.shrink()
+	}
+	return c.queryer(tx).ExecContext(ctx, query, args...)
+}
+
+// TxStmtCache is the statement cache inside transaction
+type TxStmtCache struct {
+	c  *StmtCache
+	tx *sql.Tx
+}
+
+// QueryRowContext calls QueryRowContext on cached statement inside tx
+func (c *TxStmtCache) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return c.c.queryRow(ctx, c.tx, query, args...)
+}
+
+// QueryContext calls QueryContext on cached statement inside tx
+func (c *TxStmtCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return c.c.query(ctx, c.tx, query, args...)
+}
+
+// ExecContext calls ExecContext on cached statement inside tx
+func (c *TxStmtCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.c.exec(ctx, c.tx, query, args...)
+}
+
+// isMaxPreparedStmt checks is error ER_MAX_PREPARED_STMT_COUNT_REACHED
+func isMaxPreparedStmt(err error) bool {
+	var myErr *dmysql.MySQLError
+	return errors.As(err, &myErr) && myErr.Number == 1461
+}
@@ -0,0 +1,156 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/acoshift/mysql"
+)
+
+func TestStmtCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("Reuse", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		p := mock.ExpectPrepare("select 1")
+		p.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		p.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+		p.ExpectQuery().WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow(1))
+		p.WillBeClosed()
+
+		c := mysql.NewStmtCache(db, 2)
+		_, err = c.ExecContext(ctx, "select 1", 1)
+		assert.NoError(t, err)
+		_, err = c.ExecContext(ctx, "select 1", 2)
+		assert.NoError(t, err)
+		var a int
+		err = c.QueryRowContext(ctx, "select 1", 3).Scan(&a)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, a)
+		assert.Equal(t, 1, c.Len())
+
+		assert.NoError(t, c.Close())
+		assert.Equal(t, 0, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Evict", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.MatchExpectationsInOrder(false)
+		p1 := mock.ExpectPrepare("select 1")
+		p1.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 0))
+		p1.WillBeClosed()
+		p2 := mock.ExpectPrepare("select 2")
+		p2.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 0))
+		p3 := mock.ExpectPrepare("select 3")
+		p3.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 0))
+
+		c := mysql.NewStmtCache(db, 2)
+		_, err = c.ExecContext(ctx, "select 1")
+		assert.NoError(t, err)
+		_, err = c.ExecContext(ctx, "select 2")
+		assert.NoError(t, err)
+		_, err = c.ExecContext(ctx, "select 3")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectPrepare("select 1").WillReturnError(&dmysql.MySQLError{Number: 1461})
+		mock.ExpectQuery("select 1").WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow(1))
+
+		c := mysql.NewStmtCache(db, 2)
+		rows, err := c.QueryContext(ctx, "select 1")
+		assert.NoError(t, err)
+		rows.Close()
+		assert.Equal(t, 0, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		db.SetMaxOpenConns(1)
+
+		mock.ExpectBegin()
+		// not cached query runs directly on tx connection
+		mock.ExpectExec("update t set a = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		c := mysql.NewStmtCache(db, 2)
+		err = mysql.RunInTxContext(ctx, db, nil, func(tx *sql.Tx) error {
+			_, err := c.Tx(tx).ExecContext(ctx, "update t set a = ?", 1)
+			return err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Tx cached", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		db.SetMaxOpenConns(1)
+
+		p := mock.ExpectPrepare("update t set a = ?")
+		p.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		p.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		c := mysql.NewStmtCache(db, 2)
+		_, err = c.ExecContext(ctx, "update t set a = ?", 1)
+		assert.NoError(t, err)
+
+		err = mysql.RunInTxContext(ctx, db, nil, func(tx *sql.Tx) error {
+			_, err := c.Tx(tx).ExecContext(ctx, "update t set a = ?", 2)
+			return err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Tx prepare", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		// prepare on pool, then on tx connection
+		mock.ExpectPrepare("update t set a = ?")
+		p := mock.ExpectPrepare("update t set a = ?")
+		p.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		c := mysql.NewStmtCache(db, 2)
+		c.PrepareInTx = true
+		err = mysql.RunInTxContext(ctx, db, nil, func(tx *sql.Tx) error {
+			_, err := c.Tx(tx).ExecContext(ctx, "update t set a = ?", 1)
+			return err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, c.Len())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}